@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/provider"
@@ -18,10 +19,11 @@ import (
 func PreCheck(_ *testing.T) {}
 
 type TestTools struct {
-	T                 *testing.T
-	Meta              *meta.Meta
-	ProviderFactories map[string]func() (*schema.Provider, error)
-	Cleanup           func()
+	T                        *testing.T
+	Meta                     *meta.Meta
+	ProviderFactories        map[string]func() (*schema.Provider, error)
+	ProtoV6ProviderFactories map[string]func() (tfprotov6.ProviderServer, error)
+	Cleanup                  func()
 }
 
 func NewTestTools(t *testing.T) *TestTools {
@@ -58,6 +60,16 @@ func NewTestTools(t *testing.T) *TestTools {
 				return provider.Provider(&provider.Config{Meta: m})(), nil
 			},
 		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"scaleway": func() (tfprotov6.ProviderServer, error) {
+				muxServer, err := provider.NewMuxServer(ctx, &provider.Config{Meta: m})
+				if err != nil {
+					return nil, err
+				}
+
+				return muxServer, nil
+			},
+		},
 		Cleanup: cleanup,
 	}
 }
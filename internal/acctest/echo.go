@@ -0,0 +1,129 @@
+package acctest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+var (
+	_ provider.Provider              = &echoProvider{}
+	_ resource.ResourceWithConfigure = &echoResource{}
+)
+
+// EchoProviderServer returns a test only "echo" provider.
+// The value given to the "data" attribute of its provider block is copied to the "data" attribute of the "echo" resource,
+// which allows to check in the state values that are never persisted, like the attributes of an ephemeral resource:
+//
+//	provider "echo" {
+//	  data = ephemeral.scaleway_secret_version.main.data
+//	}
+//
+//	resource "echo" "main" {}
+func EchoProviderServer() (tfprotov6.ProviderServer, error) {
+	return providerserver.NewProtocol6WithError(&echoProvider{})()
+}
+
+type echoProvider struct{}
+
+type echoProviderModel struct {
+	Data types.Dynamic `tfsdk:"data"`
+}
+
+func (p *echoProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "echo"
+}
+
+func (p *echoProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = providerschema.Schema{
+		Attributes: map[string]providerschema.Attribute{
+			"data": providerschema.DynamicAttribute{
+				Optional:    true,
+				Description: "The value copied to the data attribute of the echo resource",
+			},
+		},
+	}
+}
+
+func (p *echoProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var config echoProviderModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.ResourceData = config.Data
+}
+
+func (p *echoProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		func() resource.Resource {
+			return &echoResource{}
+		},
+	}
+}
+
+func (p *echoProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+type echoResource struct {
+	data types.Dynamic
+}
+
+func (r *echoResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName
+}
+
+func (r *echoResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resourceschema.Schema{
+		Attributes: map[string]resourceschema.Attribute{
+			"data": resourceschema.DynamicAttribute{
+				Computed:    true,
+				Description: "The value of the data attribute of the provider block",
+			},
+		},
+	}
+}
+
+func (r *echoResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(types.Dynamic)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected types.Dynamic, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.data = data
+}
+
+func (r *echoResource) Create(ctx context.Context, _ resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("data"), r.data)...)
+}
+
+func (r *echoResource) Read(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+}
+
+func (r *echoResource) Update(ctx context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("data"), r.data)...)
+}
+
+func (r *echoResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
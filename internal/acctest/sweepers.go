@@ -2,12 +2,45 @@ package acctest
 
 import (
 	"context"
+	"os"
+	"slices"
+	"time"
 
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/logging"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 )
 
+// sweeperMinAgeEnvVar overrides the age a resource must reach to be swept, e.g. TF_SWEEPER_MIN_AGE=30m
+const sweeperMinAgeEnvVar = "TF_SWEEPER_MIN_AGE"
+
+// defaultSweeperMinAge leaves the resources of the tests still running
+const defaultSweeperMinAge = 2 * time.Hour
+
+// IsOldEnoughToSweep returns whether a resource was created long enough ago to be swept.
+// A resource without creation date is considered old enough.
+func IsOldEnoughToSweep(createdAt *time.Time) bool {
+	if createdAt == nil {
+		return true
+	}
+
+	minAge := defaultSweeperMinAge
+	if rawMinAge := os.Getenv(sweeperMinAgeEnvVar); rawMinAge != "" {
+		if parsedMinAge, err := time.ParseDuration(rawMinAge); err == nil {
+			minAge = parsedMinAge
+		} else {
+			logging.L.Warningf("invalid %s %q, using %s: %s", sweeperMinAgeEnvVar, rawMinAge, minAge, err)
+		}
+	}
+
+	return time.Since(*createdAt) >= minAge
+}
+
+// HasTestTag returns whether one of the tags identifies a resource created by the tests
+func HasTestTag(tags []string) bool {
+	return slices.ContainsFunc(tags, IsTestResource)
+}
+
 func Sweep(f func(scwClient *scw.Client) error) error {
 	ctx := context.Background()
 	m, err := meta.NewMeta(ctx, &meta.Config{
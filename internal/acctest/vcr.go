@@ -246,6 +246,25 @@ func cassetteSensitiveFieldsAnonymizer(i *cassette.Interaction) error {
 	return nil
 }
 
+// SkipWithoutCassette skips the test while its cassette is not recorded, unless the cassettes are being updated.
+// The test runs in replay mode as soon as its cassette is added to the testdata folder of the package.
+func SkipWithoutCassette(t *testing.T) {
+	t.Helper()
+
+	if *UpdateCassettes {
+		return
+	}
+
+	folder, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("cannot detect working directory for testing")
+	}
+
+	if _, err := os.Stat(getTestFilePath(t, folder, ".cassette") + ".yaml"); err != nil {
+		t.Skip("Cassette not recorded yet, run with TF_UPDATE_CASSETTES=true to record it")
+	}
+}
+
 // getHTTPRecoder creates a new httpClient that records all HTTP requests in a cassette.
 // This cassette is then replayed whenever tests are executed again. This means that once the
 // requests are recorded in the cassette, no more real HTTP requests must be made to run the tests.
@@ -273,6 +292,8 @@ func getHTTPRecoder(t *testing.T, pkgFolder string, update bool) (client *http.C
 		CassetteName:       getTestFilePath(t, pkgFolder, ".cassette"),
 		Mode:               recorderMode,
 		SkipRequestLatency: true,
+		// The requests to the Kubernetes API of the clusters trust the certificate authority of the cluster given in their context
+		RealTransport: transport.NewRootCAsTransport(),
 	})
 	if err != nil {
 		return nil, nil, err
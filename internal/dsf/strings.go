@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 )
 
 func IgnoreCase(_, oldValue, newValue string, _ *schema.ResourceData) bool {
@@ -13,3 +14,18 @@ func IgnoreCase(_, oldValue, newValue string, _ *schema.ResourceData) bool {
 func IgnoreCaseAndHyphen(_, oldValue, newValue string, _ *schema.ResourceData) bool {
 	return strings.ReplaceAll(strings.ToLower(oldValue), "-", "_") == strings.ReplaceAll(strings.ToLower(newValue), "-", "_")
 }
+
+// EquivalentJSON suppresses the diff between two JSON documents that only differ by their formatting or key order
+func EquivalentJSON(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+	oldJSON, err := structure.NormalizeJsonString(oldValue)
+	if err != nil {
+		return false
+	}
+
+	newJSON, err := structure.NormalizeJsonString(newValue)
+	if err != nil {
+		return false
+	}
+
+	return oldJSON == newJSON
+}
@@ -0,0 +1,37 @@
+package regional
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/scaleway-sdk-go/validation"
+)
+
+// NameResolver returns the ID of the resource with the given name in the given region
+type NameResolver func(ctx context.Context, m interface{}, region scw.Region, name string) (string, error)
+
+// ImportStateByName is an importer accepting either a {region}/{id} or a {region}/{name} identifier.
+// Names are resolved with the given resolver, the imported resource ID is always {region}/{id}.
+func ImportStateByName(resolver NameResolver) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		region, idOrName, err := ParseID(d.Id())
+		if err != nil || idOrName == "" {
+			return nil, fmt.Errorf("invalid import identifier %q: expected {region}/{id} or {region}/{name}", d.Id())
+		}
+
+		if validation.IsUUID(idOrName) {
+			return []*schema.ResourceData{d}, nil
+		}
+
+		id, err := resolver(ctx, m, region, idOrName)
+		if err != nil {
+			return nil, fmt.Errorf("cannot import %q: %w", d.Id(), err)
+		}
+
+		d.SetId(NewIDString(region, id))
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
@@ -0,0 +1,66 @@
+package regional_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportStateByName(t *testing.T) {
+	importer := regional.ImportStateByName(func(_ context.Context, _ interface{}, region scw.Region, name string) (string, error) {
+		if region == scw.RegionNlAms && name == "my-secret" {
+			return "22222222-2222-2222-2222-222222222222", nil
+		}
+		return "", errors.New("no element found with the name " + name)
+	})
+
+	testCases := []struct {
+		name       string
+		importID   string
+		expectedID string
+		err        bool
+	}{
+		{
+			name:       "id",
+			importID:   "fr-par/11111111-1111-1111-1111-111111111111",
+			expectedID: "fr-par/11111111-1111-1111-1111-111111111111",
+		},
+		{
+			name:       "name",
+			importID:   "nl-ams/my-secret",
+			expectedID: "nl-ams/22222222-2222-2222-2222-222222222222",
+		},
+		{
+			name:     "unknown name",
+			importID: "fr-par/my-secret",
+			err:      true,
+		},
+		{
+			name:     "missing region",
+			importID: "my-secret",
+			err:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := (&schema.Resource{}).TestResourceData()
+			d.SetId(tc.importID)
+
+			res, err := importer(context.Background(), d, nil)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, res, 1)
+			assert.Equal(t, tc.expectedID, res[0].Id())
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package zonal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/scaleway-sdk-go/validation"
+)
+
+// NameResolver returns the ID of the resource with the given name in the given zone
+type NameResolver func(ctx context.Context, m interface{}, zone scw.Zone, name string) (string, error)
+
+// ImportStateByName is an importer accepting either a {zone}/{id} or a {zone}/{name} identifier.
+// Names are resolved with the given resolver, the imported resource ID is always {zone}/{id}.
+func ImportStateByName(resolver NameResolver) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		zone, idOrName, err := ParseID(d.Id())
+		if err != nil || idOrName == "" {
+			return nil, fmt.Errorf("invalid import identifier %q: expected {zone}/{id} or {zone}/{name}", d.Id())
+		}
+
+		if validation.IsUUID(idOrName) {
+			return []*schema.ResourceData{d}, nil
+		}
+
+		id, err := resolver(ctx, m, zone, idOrName)
+		if err != nil {
+			return nil, fmt.Errorf("cannot import %q: %w", d.Id(), err)
+		}
+
+		d.SetId(NewIDString(zone, id))
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
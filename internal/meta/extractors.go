@@ -10,6 +10,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
 // terraformResourceData is an interface for *schema.ResourceData. (used for mock)
@@ -101,6 +102,10 @@ func ExtractHTTPClient(m interface{}) *http.Client {
 	return m.(*Meta).HTTPClient()
 }
 
+func ExtractCustomHTTPClient(m interface{}) bool {
+	return m.(*Meta).CustomHTTPClient()
+}
+
 func getKeyInRawConfigMap(rawConfig map[string]cty.Value, key string, ty cty.Type) (interface{}, bool) {
 	if key == "" {
 		return rawConfig, false
@@ -160,3 +165,15 @@ func GetRawConfigForKey(d *schema.ResourceData, key string, ty cty.Type) (interf
 	}
 	return getKeyInRawConfigMap(rawConfig.AsValueMap(), key, ty)
 }
+
+// ExpandOrGenerateName returns the given name, or generates a random one when it is empty.
+// Generated names get the name_prefix and name_suffix of the provider.
+func ExpandOrGenerateName(m interface{}, data interface{}, prefix string) string {
+	if data != nil && data != "" {
+		return data.(string)
+	}
+
+	meta := m.(*Meta)
+
+	return meta.namePrefix + types.NewRandomName(prefix) + meta.nameSuffix
+}
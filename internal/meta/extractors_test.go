@@ -0,0 +1,90 @@
+package meta_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type providerConfig map[string]interface{}
+
+func (c providerConfig) GetOk(key string) (interface{}, bool) {
+	value, ok := c[key]
+
+	return value, ok
+}
+
+func TestExpandOrGenerateName(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		namePrefix string
+		nameSuffix string
+		data       interface{}
+		expected   *regexp.Regexp
+	}{
+		{
+			name:     "explicit name",
+			data:     "my-name",
+			expected: regexp.MustCompile(`^my-name$`),
+		},
+		{
+			name:       "explicit name is not prefixed nor suffixed",
+			namePrefix: "team-",
+			nameSuffix: "-dev",
+			data:       "my-name",
+			expected:   regexp.MustCompile(`^my-name$`),
+		},
+		{
+			name:     "generated name",
+			data:     "",
+			expected: regexp.MustCompile(`^tf-srv-[a-z]+-[a-z]+$`),
+		},
+		{
+			name:     "nil data generates a name",
+			data:     nil,
+			expected: regexp.MustCompile(`^tf-srv-[a-z]+-[a-z]+$`),
+		},
+		{
+			name:       "prefix only",
+			namePrefix: "team-",
+			expected:   regexp.MustCompile(`^team-tf-srv-[a-z]+-[a-z]+$`),
+		},
+		{
+			name:       "suffix only",
+			nameSuffix: "-dev",
+			expected:   regexp.MustCompile(`^tf-srv-[a-z]+-[a-z]+-dev$`),
+		},
+		{
+			name:       "prefix and suffix",
+			namePrefix: "team-",
+			nameSuffix: "-dev",
+			expected:   regexp.MustCompile(`^team-tf-srv-[a-z]+-[a-z]+-dev$`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := providerConfig{}
+			if tt.namePrefix != "" {
+				config["name_prefix"] = tt.namePrefix
+			}
+			if tt.nameSuffix != "" {
+				config["name_suffix"] = tt.nameSuffix
+			}
+
+			m, err := meta.NewMeta(ctx, &meta.Config{
+				ProviderSchema:   config,
+				TerraformVersion: "terraform-tests",
+			})
+			require.NoError(t, err)
+
+			assert.Regexp(t, tt.expected, meta.ExpandOrGenerateName(m, tt.data, "srv"))
+		})
+	}
+}
@@ -5,9 +5,9 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 	"github.com/scaleway/terraform-provider-scaleway/v2/version"
@@ -15,6 +15,8 @@ import (
 
 const (
 	appendUserAgentEnvVar            = "TF_APPEND_USER_AGENT"
+	sessionTokenEnvVar               = "SCW_SESSION_TOKEN"
+	sessionTokenFileEnvVar           = "SCW_SESSION_TOKEN_FILE" // #nosec G101
 	CredentialsSourceEnvironment     = "Environment variable"
 	CredentialsSourceDefault         = "Default"
 	CredentialsSourceActiveProfile   = "Active Profile in config.yaml"
@@ -40,8 +42,17 @@ type Meta struct {
 	// or it can be a http.Client used to record and replay cassettes which is useful
 	// to replay recorded interactions with APIs locally
 	httpClient *http.Client
+	// customHTTPClient is set when the HTTP client is given by the configuration, e.g. to record and replay cassettes
+	customHTTPClient bool
 	// credentialsSource stores information about the source (env, profile, etc.) of each credential
 	credentialsSource *CredentialsSource
+	// readOnly prevents any resource from being created, updated or deleted
+	readOnly bool
+	// namePrefix and nameSuffix are added to the names generated for resources without a name
+	namePrefix string
+	nameSuffix string
+	// includeErrorBodies adds the raw bodies of the failed API responses to the error diagnostics
+	includeErrorBodies bool
 }
 
 func (m Meta) ScwClient() *scw.Client {
@@ -52,6 +63,18 @@ func (m Meta) HTTPClient() *http.Client {
 	return m.httpClient
 }
 
+func (m Meta) CustomHTTPClient() bool {
+	return m.customHTTPClient
+}
+
+func (m Meta) ReadOnly() bool {
+	return m.readOnly
+}
+
+func (m Meta) IncludeErrorBodies() bool {
+	return m.includeErrorBodies
+}
+
 func (m Meta) AccessKeySource() string {
 	return m.credentialsSource.AccessKey
 }
@@ -72,8 +95,15 @@ func (m Meta) ZoneSource() string {
 	return m.credentialsSource.DefaultZone
 }
 
+// ProviderConfig is the configuration of the provider block.
+// It is implemented by *schema.ResourceData for the SDK provider and by
+// the framework provider configuration.
+type ProviderConfig interface {
+	GetOk(key string) (interface{}, bool)
+}
+
 type Config struct {
-	ProviderSchema      *schema.ResourceData
+	ProviderSchema      ProviderConfig
 	TerraformVersion    string
 	ForceZone           scw.Zone
 	ForceProjectID      string
@@ -81,6 +111,7 @@ type Config struct {
 	ForceAccessKey      string
 	ForceSecretKey      string
 	HTTPClient          *http.Client
+	ReadOnly            bool
 }
 
 // NewMeta creates the Meta object containing the SDK client.
@@ -123,7 +154,38 @@ func NewMeta(ctx context.Context, config *Config) (*Meta, error) {
 		scw.WithProfile(profile),
 	}
 
-	httpClient := &http.Client{Transport: transport.NewRetryableTransport(http.DefaultTransport)}
+	retryOptions, err := expandRetryableTransportOptions(config.ProviderSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	var baseTransport http.RoundTripper = http.DefaultTransport
+
+	sessionToken, sessionTokenFile := expandSessionToken(config.ProviderSchema)
+	if sessionTokenFile != "" {
+		// The token is set on each attempt so retries use a refreshed token.
+		sessionTokenTransport, err := transport.NewSessionTokenFileTransport(baseTransport, sessionTokenFile)
+		if err != nil {
+			return nil, err
+		}
+		sessionToken, _ = sessionTokenTransport.Token()
+		baseTransport = sessionTokenTransport
+	}
+	if sessionToken != "" {
+		opts = append(opts, scw.WithJWT(sessionToken))
+	}
+
+	httpTransport := transport.NewRetryableTransportWithOptions(baseTransport, retryOptions)
+	if endpoints := expandEndpoints(config.ProviderSchema); len(endpoints) > 0 {
+		httpTransport, err = transport.NewEndpointsTransport(httpTransport, endpoints)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// The failed responses are recorded after the retries, to describe the response the SDK returns an error for.
+	httpTransport = transport.NewFailedResponsesTransport(httpTransport)
+
+	httpClient := &http.Client{Transport: httpTransport}
 	if config.HTTPClient != nil {
 		httpClient = config.HTTPClient
 	}
@@ -134,13 +196,110 @@ func NewMeta(ctx context.Context, config *Config) (*Meta, error) {
 		return nil, err
 	}
 
+	readOnly := config.ReadOnly
+	if config.ProviderSchema != nil {
+		if rawReadOnly, exist := config.ProviderSchema.GetOk("read_only"); exist {
+			readOnly = rawReadOnly.(bool)
+		}
+	}
+
+	var namePrefix, nameSuffix string
+	if config.ProviderSchema != nil {
+		if rawNamePrefix, exist := config.ProviderSchema.GetOk("name_prefix"); exist {
+			namePrefix = rawNamePrefix.(string)
+		}
+		if rawNameSuffix, exist := config.ProviderSchema.GetOk("name_suffix"); exist {
+			nameSuffix = rawNameSuffix.(string)
+		}
+	}
+
+	var includeErrorBodies bool
+	if config.ProviderSchema != nil {
+		if rawIncludeErrorBodies, exist := config.ProviderSchema.GetOk("include_error_bodies"); exist {
+			includeErrorBodies = rawIncludeErrorBodies.(bool)
+		}
+	}
+
 	return &Meta{
-		scwClient:         scwClient,
-		httpClient:        httpClient,
-		credentialsSource: credentialsSource,
+		scwClient:          scwClient,
+		httpClient:         httpClient,
+		customHTTPClient:   config.HTTPClient != nil,
+		credentialsSource:  credentialsSource,
+		readOnly:           readOnly,
+		namePrefix:         namePrefix,
+		nameSuffix:         nameSuffix,
+		includeErrorBodies: includeErrorBodies,
 	}, nil
 }
 
+// expandRetryableTransportOptions reads the retry configuration of the provider block.
+// Unset values keep the transport defaults.
+func expandRetryableTransportOptions(d ProviderConfig) (transport.RetryableTransportOptions, error) {
+	options := transport.RetryableTransportOptions{}
+	if d == nil {
+		return options, nil
+	}
+
+	if maxRetries, exist := d.GetOk("max_retries"); exist {
+		retryMax := maxRetries.(int)
+		options.RetryMax = &retryMax
+	}
+
+	for key, option := range map[string]**time.Duration{
+		"retry_wait_min": &options.RetryWaitMin,
+		"retry_wait_max": &options.RetryWaitMax,
+	} {
+		rawDuration, exist := d.GetOk(key)
+		if !exist {
+			continue
+		}
+		duration, err := time.ParseDuration(rawDuration.(string))
+		if err != nil {
+			return options, fmt.Errorf("invalid %s: %w", key, err)
+		}
+		*option = &duration
+	}
+
+	return options, nil
+}
+
+// expandEndpoints reads the API endpoints overrides of the provider block
+func expandEndpoints(d ProviderConfig) map[string]string {
+	if d == nil {
+		return nil
+	}
+
+	rawEndpoints, exist := d.GetOk("endpoints")
+	if !exist {
+		return nil
+	}
+
+	endpoints := make(map[string]string)
+	for product, endpoint := range rawEndpoints.(map[string]interface{}) {
+		endpoints[product] = endpoint.(string)
+	}
+
+	return endpoints
+}
+
+// expandSessionToken reads the session token and the session token file of the provider block,
+// falling back to the environment variables
+func expandSessionToken(d ProviderConfig) (token string, tokenFile string) {
+	token = os.Getenv(sessionTokenEnvVar)
+	tokenFile = os.Getenv(sessionTokenFileEnvVar)
+
+	if d != nil {
+		if rawToken, exist := d.GetOk("session_token"); exist {
+			token = rawToken.(string)
+		}
+		if rawTokenFile, exist := d.GetOk("session_token_file"); exist {
+			tokenFile = rawTokenFile.(string)
+		}
+	}
+
+	return token, tokenFile
+}
+
 func customizeUserAgent(providerVersion string, terraformVersion string) string {
 	userAgent := fmt.Sprintf("terraform-provider/%s terraform/%s", providerVersion, terraformVersion)
 
@@ -152,7 +311,7 @@ func customizeUserAgent(providerVersion string, terraformVersion string) string
 }
 
 //gocyclo:ignore
-func loadProfile(ctx context.Context, d *schema.ResourceData) (*scw.Profile, *CredentialsSource, error) {
+func loadProfile(ctx context.Context, d ProviderConfig) (*scw.Profile, *CredentialsSource, error) {
 	config, err := scw.LoadConfig()
 	// If the config file do not exist, don't return an error as we may find config in ENV or flags.
 	if _, isNotFoundError := err.(*scw.ConfigFileNotFoundError); isNotFoundError {
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
+)
+
+// sdkErrorPrefix prefixes the messages of the errors returned by the Scaleway SDK
+const sdkErrorPrefix = "scaleway-sdk-go: "
+
+// addErrorDetails wraps the functions of every resource and data source so the errors returned
+// by the Scaleway APIs are detailed with the request ID, the HTTP status and a suggested action.
+func addErrorDetails(provider *schema.Provider) {
+	for resourceName, resource := range provider.ResourcesMap {
+		resource.CreateContext = errorDetails(resourceName, resource.CreateContext)
+		resource.CreateWithoutTimeout = errorDetails(resourceName, resource.CreateWithoutTimeout)
+		resource.ReadContext = errorDetails(resourceName, resource.ReadContext)
+		resource.ReadWithoutTimeout = errorDetails(resourceName, resource.ReadWithoutTimeout)
+		resource.UpdateContext = errorDetails(resourceName, resource.UpdateContext)
+		resource.UpdateWithoutTimeout = errorDetails(resourceName, resource.UpdateWithoutTimeout)
+		resource.DeleteContext = errorDetails(resourceName, resource.DeleteContext)
+		resource.DeleteWithoutTimeout = errorDetails(resourceName, resource.DeleteWithoutTimeout)
+	}
+
+	for dataSourceName, dataSource := range provider.DataSourcesMap {
+		dataSource.ReadContext = errorDetails(dataSourceName, dataSource.ReadContext)
+		dataSource.ReadWithoutTimeout = errorDetails(dataSourceName, dataSource.ReadWithoutTimeout)
+	}
+}
+
+func errorDetails[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](resourceName string, f F) F {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		ctx, failedResponses := transport.ContextWithFailedResponses(ctx)
+
+		diags := f(ctx, d, m)
+		if !diags.HasError() {
+			return diags
+		}
+
+		includeBody := false
+		if providerMeta, ok := m.(*meta.Meta); ok {
+			includeBody = providerMeta.IncludeErrorBodies()
+		}
+
+		locator := resourceName
+		if d.Id() != "" {
+			locator = fmt.Sprintf("%s (ID %s)", resourceName, d.Id())
+		}
+
+		return DetailDiagnostics(diags, failedResponses.All(), locator, includeBody)
+	}
+}
+
+// DetailDiagnostics adds the details of the failed API response which caused each error diagnostic.
+// The response is the last one with the status of the error, or the last failed response when the error does not include its status.
+func DetailDiagnostics(diags diag.Diagnostics, failedResponses []*transport.FailedResponse, locator string, includeBody bool) diag.Diagnostics {
+	if len(failedResponses) == 0 {
+		return diags
+	}
+
+	for i := range diags {
+		if diags[i].Severity != diag.Error || !strings.Contains(diags[i].Summary, sdkErrorPrefix) {
+			continue
+		}
+
+		failedResponse := failedResponses[len(failedResponses)-1]
+		for j := len(failedResponses) - 1; j >= 0; j-- {
+			if strings.Contains(diags[i].Summary, failedResponses[j].Status) {
+				failedResponse = failedResponses[j]
+				break
+			}
+		}
+
+		details := []string{
+			"Request ID: " + valueOrUnknown(failedResponse.RequestID),
+			"HTTP status: " + failedResponse.Status,
+			fmt.Sprintf("Request: %s %s", failedResponse.Method, failedResponse.URL),
+			"Resource: " + locator,
+			"Suggested action: " + suggestedAction(failedResponse),
+		}
+		if includeBody && len(failedResponse.Body) > 0 {
+			details = append(details, "Response body: "+string(failedResponse.Body))
+		}
+
+		if diags[i].Detail != "" {
+			details = append([]string{diags[i].Detail, ""}, details...)
+		}
+		diags[i].Detail = strings.Join(details, "\n")
+	}
+
+	return diags
+}
+
+// suggestedAction returns what the user can do to solve the error of the response
+func suggestedAction(failedResponse *transport.FailedResponse) string {
+	apiError := struct {
+		Type string `json:"type"`
+	}{}
+	_ = json.Unmarshal(failedResponse.Body, &apiError)
+
+	switch {
+	case failedResponse.StatusCode == http.StatusBadRequest:
+		return "Check the arguments of the resource, the API rejected them."
+	case failedResponse.StatusCode == http.StatusUnauthorized:
+		return "Check the access key and secret key used by the provider."
+	case failedResponse.StatusCode == http.StatusForbidden && apiError.Type == "quotas_exceeded":
+		return "Delete unused resources or request a quota increase in the console."
+	case failedResponse.StatusCode == http.StatusForbidden:
+		return "Check the IAM permissions of the API key on the project of the resource."
+	case failedResponse.StatusCode == http.StatusNotFound:
+		return "The resource may have been deleted outside of Terraform, refresh the state or remove the resource from it."
+	case failedResponse.StatusCode == http.StatusConflict, failedResponse.StatusCode == http.StatusLocked:
+		return "The resource is busy, apply again once its current operation is done."
+	case failedResponse.StatusCode == http.StatusPreconditionFailed:
+		return "The resource is still used by another one, detach or delete it first."
+	case failedResponse.StatusCode == http.StatusTooManyRequests:
+		return "The API is rate-limited, increase max_retries or retry_wait_max in the provider block."
+	case failedResponse.StatusCode >= http.StatusInternalServerError:
+		return "Apply again later, contact the support with the request ID if the error persists."
+	default:
+		return "Contact the support with the request ID if the error persists."
+	}
+}
+
+func valueOrUnknown(value string) string {
+	if value == "" {
+		return "unknown"
+	}
+
+	return value
+}
@@ -0,0 +1,68 @@
+package provider_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/provider"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetailDiagnostics(t *testing.T) {
+	failedResponses := []*transport.FailedResponse{
+		{
+			RequestID:  "request-forbidden",
+			Method:     http.MethodPost,
+			URL:        "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers",
+			Status:     "403 Forbidden",
+			StatusCode: http.StatusForbidden,
+			Body:       []byte(`{"type":"quotas_exceeded","message":"quota exceeded"}`),
+		},
+		{
+			RequestID:  "request-not-found",
+			Method:     http.MethodGet,
+			URL:        "https://api.scaleway.com/instance/v1/zones/fr-par-1/ips/11111111-1111-1111-1111-111111111111",
+			Status:     "404 Not Found",
+			StatusCode: http.StatusNotFound,
+		},
+	}
+
+	diags := provider.DetailDiagnostics(diag.Diagnostics{
+		{Severity: diag.Error, Summary: "scaleway-sdk-go: http error 403 Forbidden: quota exceeded"},
+		{Severity: diag.Error, Summary: "scaleway-sdk-go: resource ip with ID 11111111-1111-1111-1111-111111111111 is not found"},
+		{Severity: diag.Error, Summary: "invalid zone"},
+		{Severity: diag.Warning, Summary: "scaleway-sdk-go: http error 403 Forbidden"},
+	}, failedResponses, "scaleway_instance_server", true)
+
+	assert.Contains(t, diags[0].Detail, "Request ID: request-forbidden")
+	assert.Contains(t, diags[0].Detail, "HTTP status: 403 Forbidden")
+	assert.Contains(t, diags[0].Detail, "Resource: scaleway_instance_server")
+	assert.Contains(t, diags[0].Detail, "Suggested action: Delete unused resources or request a quota increase")
+	assert.Contains(t, diags[0].Detail, `Response body: {"type":"quotas_exceeded"`)
+
+	// The error does not include its status, the last failed response is used
+	assert.Contains(t, diags[1].Detail, "Request ID: request-not-found")
+	assert.Contains(t, diags[1].Detail, "Suggested action: The resource may have been deleted outside of Terraform")
+
+	// Errors not returned by the SDK and warnings are left unchanged
+	assert.Empty(t, diags[2].Detail)
+	assert.Empty(t, diags[3].Detail)
+}
+
+func TestDetailDiagnostics_WithoutBody(t *testing.T) {
+	diags := provider.DetailDiagnostics(diag.Diagnostics{
+		{Severity: diag.Error, Summary: "scaleway-sdk-go: http error 429 Too Many Requests", Detail: "while creating the server"},
+	}, []*transport.FailedResponse{{
+		Method:     http.MethodPost,
+		URL:        "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers",
+		Status:     "429 Too Many Requests",
+		StatusCode: http.StatusTooManyRequests,
+		Body:       []byte(`{"message":"rate limited"}`),
+	}}, "scaleway_instance_server", false)
+
+	assert.Contains(t, diags[0].Detail, "while creating the server\n\nRequest ID: unknown")
+	assert.Contains(t, diags[0].Detail, "increase max_retries or retry_wait_max")
+	assert.NotContains(t, diags[0].Detail, "Response body")
+}
@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/cockpit"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/domain"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/secret"
+)
+
+var (
+	_ provider.Provider                       = &ScalewayProvider{}
+	_ provider.ProviderWithEphemeralResources = &ScalewayProvider{}
+)
+
+// ScalewayProvider is the terraform-plugin-framework implementation of the provider.
+// It is muxed with the terraform-plugin-sdk provider and must share its provider schema.
+type ScalewayProvider struct {
+	config *Config
+}
+
+// NewFrameworkProvider returns the terraform-plugin-framework provider.
+func NewFrameworkProvider(config *Config) func() provider.Provider {
+	return func() provider.Provider {
+		return &ScalewayProvider{config: config}
+	}
+}
+
+type frameworkProviderModel struct {
+	AccessKey          types.String `tfsdk:"access_key"`
+	SecretKey          types.String `tfsdk:"secret_key"`
+	SessionToken       types.String `tfsdk:"session_token"`
+	SessionTokenFile   types.String `tfsdk:"session_token_file"`
+	Profile            types.String `tfsdk:"profile"`
+	ProjectID          types.String `tfsdk:"project_id"`
+	OrganizationID     types.String `tfsdk:"organization_id"`
+	Region             types.String `tfsdk:"region"`
+	Zone               types.String `tfsdk:"zone"`
+	APIURL             types.String `tfsdk:"api_url"`
+	ReadOnly           types.Bool   `tfsdk:"read_only"`
+	NamePrefix         types.String `tfsdk:"name_prefix"`
+	NameSuffix         types.String `tfsdk:"name_suffix"`
+	Endpoints          types.Map    `tfsdk:"endpoints"`
+	MaxRetries         types.Int64  `tfsdk:"max_retries"`
+	RetryWaitMin       types.String `tfsdk:"retry_wait_min"`
+	RetryWaitMax       types.String `tfsdk:"retry_wait_max"`
+	IncludeErrorBodies types.Bool   `tfsdk:"include_error_bodies"`
+}
+
+// GetOk implements meta.ProviderConfig with the same semantic as schema.ResourceData.GetOk:
+// null, unknown and zero values are reported as not set.
+func (c *frameworkProviderModel) GetOk(key string) (interface{}, bool) {
+	var value types.String
+
+	switch key {
+	case "access_key":
+		value = c.AccessKey
+	case "secret_key":
+		value = c.SecretKey
+	case "session_token":
+		value = c.SessionToken
+	case "session_token_file":
+		value = c.SessionTokenFile
+	case "profile":
+		value = c.Profile
+	case "project_id":
+		value = c.ProjectID
+	case "organization_id":
+		value = c.OrganizationID
+	case "region":
+		value = c.Region
+	case "zone":
+		value = c.Zone
+	case "api_url":
+		value = c.APIURL
+	case "read_only":
+		return c.ReadOnly.ValueBool(), c.ReadOnly.ValueBool()
+	case "name_prefix":
+		value = c.NamePrefix
+	case "name_suffix":
+		value = c.NameSuffix
+	case "endpoints":
+		if c.Endpoints.IsNull() || c.Endpoints.IsUnknown() || len(c.Endpoints.Elements()) == 0 {
+			return nil, false
+		}
+		endpoints := make(map[string]interface{}, len(c.Endpoints.Elements()))
+		for product, endpoint := range c.Endpoints.Elements() {
+			if endpoint, ok := endpoint.(types.String); ok {
+				endpoints[product] = endpoint.ValueString()
+			}
+		}
+		return endpoints, true
+	case "max_retries":
+		return int(c.MaxRetries.ValueInt64()), c.MaxRetries.ValueInt64() != 0
+	case "retry_wait_min":
+		value = c.RetryWaitMin
+	case "retry_wait_max":
+		value = c.RetryWaitMax
+	case "include_error_bodies":
+		return c.IncludeErrorBodies.ValueBool(), c.IncludeErrorBodies.ValueBool()
+	default:
+		return nil, false
+	}
+
+	if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+		return "", false
+	}
+
+	return value.ValueString(), true
+}
+
+func (p *ScalewayProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "scaleway"
+}
+
+func (p *ScalewayProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_key": schema.StringAttribute{
+				Optional:    true,
+				Description: "The Scaleway access key.",
+			},
+			"secret_key": schema.StringAttribute{
+				Optional:    true,
+				Description: "The Scaleway secret Key.",
+			},
+			"session_token": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "A Scaleway session token, used instead of the access and secret keys.",
+			},
+			"session_token_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "The path of a file containing a Scaleway session token. The file is read again when it changes, to use refreshed tokens.",
+			},
+			"profile": schema.StringAttribute{
+				Optional:    true,
+				Description: "The Scaleway profile to use.",
+			},
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The Scaleway project ID.",
+			},
+			"organization_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The Scaleway organization ID.",
+			},
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Description: "The region you want to attach the resource to",
+			},
+			"zone": schema.StringAttribute{
+				Optional:    true,
+				Description: "The zone you want to attach the resource to",
+			},
+			"api_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "The Scaleway API URL to use.",
+			},
+			"read_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Prevent the provider from creating, updating or deleting any resource.",
+			},
+			"name_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "A prefix added to the names generated for resources without a name.",
+			},
+			"name_suffix": schema.StringAttribute{
+				Optional:    true,
+				Description: "A suffix added to the names generated for resources without a name.",
+			},
+			"endpoints": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Custom endpoints of API products, e.g. instance = \"https://proxy.internal\". The key is the product name of the API path.",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of retries of a failed API request (rate-limited, server error or network error).",
+			},
+			"retry_wait_min": schema.StringAttribute{
+				Optional:    true,
+				Description: "The minimum time to wait between two retries of an API request (e.g. 2s).",
+			},
+			"retry_wait_max": schema.StringAttribute{
+				Optional:    true,
+				Description: "The maximum time to wait between two retries of an API request (e.g. 2m).",
+			},
+			"include_error_bodies": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Add the raw bodies of the failed API responses to the errors.",
+			},
+		},
+	}
+}
+
+func (p *ScalewayProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	// If we provide meta in config use it. This is useful for tests
+	if p.config != nil && p.config.Meta != nil {
+		resp.EphemeralResourceData = p.config.Meta
+		resp.DataSourceData = p.config.Meta
+		resp.ResourceData = p.config.Meta
+
+		return
+	}
+
+	var data frameworkProviderModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	m, err := meta.NewMeta(ctx, &meta.Config{
+		ProviderSchema:   &data,
+		TerraformVersion: req.TerraformVersion,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to configure the provider", err.Error())
+
+		return
+	}
+
+	resp.EphemeralResourceData = m
+	resp.DataSourceData = m
+	resp.ResourceData = m
+}
+
+func (p *ScalewayProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{}
+}
+
+func (p *ScalewayProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
+
+func (p *ScalewayProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		cockpit.NewTokenEphemeralResource,
+		domain.NewAuthCodeEphemeralResource,
+		secret.NewVersionEphemeralResource,
+	}
+}
@@ -0,0 +1,24 @@
+package provider_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMuxServer(t *testing.T) {
+	ctx := context.Background()
+
+	muxServer, err := provider.NewMuxServer(ctx, provider.DefaultConfig())
+	require.NoError(t, err)
+
+	// Muxed providers must share the same provider schema.
+	resp, err := muxServer.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Diagnostics)
+	assert.Contains(t, resp.EphemeralResourceSchemas, "scaleway_secret_version")
+}
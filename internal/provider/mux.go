@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+)
+
+// NewMuxServer creates a protocol v6 server serving both the provider using terraform-plugin-sdk
+// and the provider using terraform-plugin-framework.
+// The SDK provider only speaks protocol v5, it is upgraded to be muxed with the framework provider.
+func NewMuxServer(ctx context.Context, config *Config) (tfprotov6.ProviderServer, error) {
+	upgradedSdkProvider, err := tf5to6server.UpgradeServer(ctx, Provider(config)().GRPCProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	providers := []func() tfprotov6.ProviderServer{
+		func() tfprotov6.ProviderServer {
+			return upgradedSdkProvider
+		},
+		providerserver.NewProtocol6(NewFrameworkProvider(config)()),
+	}
+
+	muxServer, err := tf6muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		return nil, err
+	}
+
+	return muxServer.ProviderServer(), nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
@@ -19,6 +20,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/block"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/cockpit"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/container"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/dedibox"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/domain"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/flexibleip"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/function"
@@ -29,6 +31,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/ipam"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/jobs"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/k8s"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/keymanager"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/lb"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/marketplace"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/mnq"
@@ -91,6 +94,17 @@ func Provider(config *Config) plugin.ProviderFunc {
 					Description:      "The Scaleway secret Key.",
 					ValidateDiagFunc: verify.IsUUID(),
 				},
+				"session_token": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "A Scaleway session token, used instead of the access and secret keys.",
+				},
+				"session_token_file": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The path of a file containing a Scaleway session token. The file is read again when it changes, to use refreshed tokens.",
+				},
 				"profile": {
 					Type:        schema.TypeString,
 					Optional:    true, // To allow user to use `access_key`, `secret_key`, `project_id`...
@@ -108,13 +122,57 @@ func Provider(config *Config) plugin.ProviderFunc {
 					Description:      "The Scaleway organization ID.",
 					ValidateDiagFunc: verify.IsUUID(),
 				},
-				"region": regional.Schema(),
-				"zone":   zonal.Schema(),
+				"region": providerLocalitySchema(regional.Schema()),
+				"zone":   providerLocalitySchema(zonal.Schema()),
 				"api_url": {
 					Type:        schema.TypeString,
 					Optional:    true,
 					Description: "The Scaleway API URL to use.",
 				},
+				"read_only": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Prevent the provider from creating, updating or deleting any resource.",
+				},
+				"name_prefix": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "A prefix added to the names generated for resources without a name.",
+				},
+				"name_suffix": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "A suffix added to the names generated for resources without a name.",
+				},
+				"endpoints": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Custom endpoints of API products, e.g. instance = \"https://proxy.internal\". The key is the product name of the API path.",
+				},
+				"max_retries": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The maximum number of retries of a failed API request (rate-limited, server error or network error).",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"retry_wait_min": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Description:      "The minimum time to wait between two retries of an API request (e.g. 2s).",
+					ValidateDiagFunc: verify.IsDuration(),
+				},
+				"retry_wait_max": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Description:      "The maximum time to wait between two retries of an API request (e.g. 2m).",
+					ValidateDiagFunc: verify.IsDuration(),
+				},
+				"include_error_bodies": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Add the raw bodies of the failed API responses to the errors.",
+				},
 			},
 
 			ResourcesMap: map[string]*schema.Resource{
@@ -126,6 +184,7 @@ func Provider(config *Config) plugin.ProviderFunc {
 				"scaleway_block_volume":                        block.ResourceVolume(),
 				"scaleway_cockpit":                             cockpit.ResourceCockpit(),
 				"scaleway_cockpit_source":                      cockpit.ResourceCockpitSource(),
+				"scaleway_cockpit_grafana_dashboard":           cockpit.ResourceCockpitGrafanaDashboard(),
 				"scaleway_cockpit_grafana_user":                cockpit.ResourceCockpitGrafanaUser(),
 				"scaleway_cockpit_token":                       cockpit.ResourceToken(),
 				"scaleway_cockpit_alert_manager":               cockpit.ResourceCockpitAlertManager(),
@@ -135,10 +194,15 @@ func Provider(config *Config) plugin.ProviderFunc {
 				"scaleway_container_namespace":                 container.ResourceNamespace(),
 				"scaleway_container_token":                     container.ResourceToken(),
 				"scaleway_container_trigger":                   container.ResourceTrigger(),
+				"scaleway_dedibox_failover_ip_attachment":      dedibox.ResourceFailoverIPAttachment(),
+				"scaleway_domain_contacts_sync":                domain.ResourceContactsSync(),
 				"scaleway_domain_record":                       domain.ResourceRecord(),
+				"scaleway_domain_whois_privacy":                domain.ResourceWhoisPrivacy(),
 				"scaleway_domain_zone":                         domain.ResourceZone(),
+				"scaleway_domain_zone_records":                 domain.ResourceZoneRecords(),
 				"scaleway_flexible_ip":                         flexibleip.ResourceIP(),
 				"scaleway_flexible_ip_mac_address":             flexibleip.ResourceMACAddress(),
+				"scaleway_flexible_ip_reverses":                flexibleip.ResourceReverses(),
 				"scaleway_function":                            function.ResourceFunction(),
 				"scaleway_function_cron":                       function.ResourceCron(),
 				"scaleway_function_domain":                     function.ResourceDomain(),
@@ -151,6 +215,7 @@ func Provider(config *Config) plugin.ProviderFunc {
 				"scaleway_iam_group_membership":                iam.ResourceGroupMembership(),
 				"scaleway_iam_policy":                          iam.ResourcePolicy(),
 				"scaleway_iam_ssh_key":                         iam.ResourceSSKKey(),
+				"scaleway_iam_ssh_key_attachment":              iam.ResourceSSHKeyAttachment(),
 				"scaleway_iam_user":                            iam.ResourceUser(),
 				"scaleway_inference_deployment":                inference.ResourceDeployment(),
 				"scaleway_instance_image":                      instance.ResourceImage(),
@@ -161,6 +226,7 @@ func Provider(config *Config) plugin.ProviderFunc {
 				"scaleway_instance_security_group":             instance.ResourceSecurityGroup(),
 				"scaleway_instance_security_group_rules":       instance.ResourceSecurityGroupRules(),
 				"scaleway_instance_server":                     instance.ResourceServer(),
+				"scaleway_instance_servers_action":             instance.ResourceServersAction(),
 				"scaleway_instance_snapshot":                   instance.ResourceSnapshot(),
 				"scaleway_instance_user_data":                  instance.ResourceUserData(),
 				"scaleway_instance_volume":                     instance.ResourceVolume(),
@@ -171,8 +237,10 @@ func Provider(config *Config) plugin.ProviderFunc {
 				"scaleway_ipam_ip":                             ipam.ResourceIP(),
 				"scaleway_ipam_ip_reverse_dns":                 ipam.ResourceIPReverseDNS(),
 				"scaleway_job_definition":                      jobs.ResourceDefinition(),
+				"scaleway_job_run":                             jobs.ResourceRun(),
 				"scaleway_k8s_cluster":                         k8s.ResourceCluster(),
 				"scaleway_k8s_pool":                            k8s.ResourcePool(),
+				"scaleway_key_manager_key":                     keymanager.ResourceKey(),
 				"scaleway_lb":                                  lb.ResourceLb(),
 				"scaleway_lb_acl":                              lb.ResourceACL(),
 				"scaleway_lb_backend":                          lb.ResourceBackend(),
@@ -181,7 +249,9 @@ func Provider(config *Config) plugin.ProviderFunc {
 				"scaleway_lb_ip":                               lb.ResourceIP(),
 				"scaleway_lb_route":                            lb.ResourceRoute(),
 				"scaleway_mnq_nats_account":                    mnq.ResourceNatsAccount(),
+				"scaleway_mnq_nats_consumer":                   mnq.ResourceNatsConsumer(),
 				"scaleway_mnq_nats_credentials":                mnq.ResourceNatsCredentials(),
+				"scaleway_mnq_nats_stream":                     mnq.ResourceNatsStream(),
 				"scaleway_mnq_sns":                             mnq.ResourceSNS(),
 				"scaleway_mnq_sns_credentials":                 mnq.ResourceSNSCredentials(),
 				"scaleway_mnq_sns_topic":                       mnq.ResourceSNSTopic(),
@@ -223,6 +293,8 @@ func Provider(config *Config) plugin.ProviderFunc {
 				"scaleway_vpc_public_gateway_pat_rule":         vpcgw.ResourcePATRule(),
 				"scaleway_vpc_route":                           vpc.ResourceRoute(),
 				"scaleway_webhosting":                          webhosting.ResourceWebhosting(),
+				"scaleway_webhosting_ftp_account":              webhosting.ResourceFtpAccount(),
+				"scaleway_webhosting_mail_account":             webhosting.ResourceMailAccount(),
 			},
 
 			DataSourcesMap: map[string]*schema.Resource{
@@ -238,11 +310,15 @@ func Provider(config *Config) plugin.ProviderFunc {
 				"scaleway_block_snapshot":                      block.DataSourceSnapshot(),
 				"scaleway_block_volume":                        block.DataSourceVolume(),
 				"scaleway_cockpit":                             cockpit.DataSourceCockpit(),
+				"scaleway_cockpit_managed_alerts":              cockpit.DataSourceManagedAlerts(),
 				"scaleway_cockpit_plan":                        cockpit.DataSourcePlan(),
+				"scaleway_cockpit_usage":                       cockpit.DataSourceUsage(),
 				"scaleway_config":                              scwconfig.DataSourceConfig(),
 				"scaleway_container":                           container.DataSourceContainer(),
 				"scaleway_container_namespace":                 container.DataSourceNamespace(),
+				"scaleway_dedibox_server":                      dedibox.DataSourceServer(),
 				"scaleway_domain_record":                       domain.DataSourceRecord(),
+				"scaleway_domain_registration":                 domain.DataSourceRegistration(),
 				"scaleway_domain_zone":                         domain.DataSourceZone(),
 				"scaleway_flexible_ip":                         flexibleip.DataSourceFlexibleIP(),
 				"scaleway_flexible_ips":                        flexibleip.DataSourceFlexibleIPs(),
@@ -250,11 +326,15 @@ func Provider(config *Config) plugin.ProviderFunc {
 				"scaleway_function_namespace":                  function.DataSourceNamespace(),
 				"scaleway_iam_application":                     iam.DataSourceApplication(),
 				"scaleway_iam_group":                           iam.DataSourceGroup(),
+				"scaleway_iam_quotas":                          iam.DataSourceQuotas(),
 				"scaleway_iam_ssh_key":                         iam.DataSourceSSHKey(),
 				"scaleway_iam_user":                            iam.DataSourceUser(),
+				"scaleway_iam_users":                           iam.DataSourceUsers(),
 				"scaleway_iam_api_key":                         iam.DataSourceAPIKey(),
+				"scaleway_inference_model":                     inference.DataSourceModel(),
 				"scaleway_instance_image":                      instance.DataSourceImage(),
 				"scaleway_instance_ip":                         instance.DataSourceIP(),
+				"scaleway_instance_ips":                        instance.DataSourceIPs(),
 				"scaleway_instance_placement_group":            instance.DataSourcePlacementGroup(),
 				"scaleway_instance_private_nic":                instance.DataSourcePrivateNIC(),
 				"scaleway_instance_security_group":             instance.DataSourceSecurityGroup(),
@@ -266,9 +346,11 @@ func Provider(config *Config) plugin.ProviderFunc {
 				"scaleway_iot_hub":                             iot.DataSourceHub(),
 				"scaleway_ipam_ip":                             ipam.DataSourceIP(),
 				"scaleway_ipam_ips":                            ipam.DataSourceIPs(),
+				"scaleway_job_runs":                            jobs.DataSourceRuns(),
 				"scaleway_k8s_cluster":                         k8s.DataSourceCluster(),
 				"scaleway_k8s_pool":                            k8s.DataSourcePool(),
 				"scaleway_k8s_version":                         k8s.DataSourceVersion(),
+				"scaleway_key_manager_key":                     keymanager.DataSourceKey(),
 				"scaleway_lb":                                  lb.DataSourceLb(),
 				"scaleway_lb_acls":                             lb.DataSourceACLs(),
 				"scaleway_lb_backend":                          lb.DataSourceBackend(),
@@ -280,25 +362,34 @@ func Provider(config *Config) plugin.ProviderFunc {
 				"scaleway_lb_ips":                              lb.DataSourceIPs(),
 				"scaleway_lb_route":                            lb.DataSourceRoute(),
 				"scaleway_lb_routes":                           lb.DataSourceRoutes(),
+				"scaleway_lb_stats":                            lb.DataSourceStats(),
 				"scaleway_lbs":                                 lb.DataSourceLbs(),
 				"scaleway_marketplace_image":                   marketplace.DataSourceImage(),
 				"scaleway_mnq_sqs":                             mnq.DataSourceSQS(),
 				"scaleway_mnq_sns":                             mnq.DataSourceSNS(),
+				"scaleway_mnq_sns_topics":                      mnq.DataSourceSNSTopics(),
+				"scaleway_mnq_sqs_queues":                      mnq.DataSourceSQSQueues(),
+				"scaleway_mnq_sqs_queue":                       mnq.DataSourceSQSQueue(),
 				"scaleway_mongodb_instance":                    mongodb.DataSourceInstance(),
 				"scaleway_object_bucket":                       object.DataSourceBucket(),
 				"scaleway_object_bucket_policy":                object.DataSourceBucketPolicy(),
+				"scaleway_object_endpoint":                     object.DataSourceEndpoint(),
 				"scaleway_rdb_acl":                             rdb.DataSourceACL(),
 				"scaleway_rdb_database":                        rdb.DataSourceDatabase(),
 				"scaleway_rdb_database_backup":                 rdb.DataSourceDatabaseBackup(),
 				"scaleway_rdb_instance":                        rdb.DataSourceInstance(),
 				"scaleway_rdb_privilege":                       rdb.DataSourcePrivilege(),
 				"scaleway_redis_cluster":                       redis.DataSourceCluster(),
+				"scaleway_regions":                             az.DataSourceRegions(),
 				"scaleway_registry_image":                      registry.DataSourceImage(),
 				"scaleway_registry_namespace":                  registry.DataSourceNamespace(),
 				"scaleway_registry_image_tag":                  registry.DataSourceImageTag(),
+				"scaleway_sdb_sql_database_backups":            sdb.DataSourceDatabaseBackups(),
 				"scaleway_secret":                              secret.DataSourceSecret(),
 				"scaleway_secret_version":                      secret.DataSourceVersion(),
+				"scaleway_secrets":                             secret.DataSourceSecrets(),
 				"scaleway_tem_domain":                          tem.DataSourceDomain(),
+				"scaleway_tem_domain_last_status":              tem.DataSourceDomainLastStatus(),
 				"scaleway_vpc":                                 vpc.DataSourceVPC(),
 				"scaleway_vpc_gateway_network":                 vpcgw.DataSourceNetwork(),
 				"scaleway_vpc_private_network":                 vpc.DataSourcePrivateNetwork(),
@@ -310,11 +401,14 @@ func Provider(config *Config) plugin.ProviderFunc {
 				"scaleway_vpc_routes":                          vpc.DataSourceRoutes(),
 				"scaleway_vpcs":                                vpc.DataSourceVPCs(),
 				"scaleway_webhosting":                          webhosting.DataSourceWebhosting(),
+				"scaleway_webhosting_dns_records":              webhosting.DataSourceDNSRecords(),
 				"scaleway_webhosting_offer":                    webhosting.DataSourceOffer(),
 			},
 		}
 
 		addBetaResources(p)
+		addReadOnlyGuards(p)
+		addErrorDetails(p)
 
 		p.ConfigureContextFunc = func(ctx context.Context, data *schema.ResourceData) (interface{}, diag.Diagnostics) {
 			terraformVersion := p.TerraformVersion
@@ -338,4 +432,14 @@ func Provider(config *Config) plugin.ProviderFunc {
 	}
 }
 
+// providerLocalitySchema adapts a locality schema to the provider block.
+// Provider attributes cannot be computed in terraform-plugin-framework, the schema must be
+// identical in both providers to be muxed.
+func providerLocalitySchema(s *schema.Schema) *schema.Schema {
+	s.Computed = false
+	s.ForceNew = false
+
+	return s
+}
+
 //gocyclo:ignore
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+)
+
+// addReadOnlyGuards wraps the create, update and delete functions of every resource
+// so they fail when the provider is configured with read_only = true.
+func addReadOnlyGuards(provider *schema.Provider) {
+	for resourceName, resource := range provider.ResourcesMap {
+		resource.CreateContext = readOnlyGuard(resourceName, "create", resource.CreateContext)
+		resource.CreateWithoutTimeout = readOnlyGuard(resourceName, "create", resource.CreateWithoutTimeout)
+		resource.UpdateContext = readOnlyGuard(resourceName, "update", resource.UpdateContext)
+		resource.UpdateWithoutTimeout = readOnlyGuard(resourceName, "update", resource.UpdateWithoutTimeout)
+		resource.DeleteContext = readOnlyGuard(resourceName, "delete", resource.DeleteContext)
+		resource.DeleteWithoutTimeout = readOnlyGuard(resourceName, "delete", resource.DeleteWithoutTimeout)
+	}
+}
+
+func readOnlyGuard[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](resourceName string, action string, f F) F {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if providerMeta, ok := m.(*meta.Meta); ok && providerMeta.ReadOnly() {
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("cannot %s %s: provider is in read-only mode", action, resourceName),
+				Detail:   "The provider is configured with read_only = true, only data sources and refresh of existing resources are allowed.",
+			}}
+		}
+
+		return f(ctx, d, m)
+	}
+}
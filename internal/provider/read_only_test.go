@@ -0,0 +1,41 @@
+package provider_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadOnlyGuards(t *testing.T) {
+	ctx := context.Background()
+
+	m, err := meta.NewMeta(ctx, &meta.Config{
+		TerraformVersion: "terraform-tests",
+		ReadOnly:         true,
+	})
+	require.NoError(t, err)
+
+	p := provider.Provider(&provider.Config{Meta: m})()
+	require.NoError(t, p.InternalValidate())
+
+	for resourceName, resource := range p.ResourcesMap {
+		d := resource.TestResourceData()
+
+		if resource.CreateContext != nil {
+			diags := resource.CreateContext(ctx, d, m)
+			assert.True(t, diags.HasError(), "create of %s should fail in read-only mode", resourceName)
+		}
+		if resource.UpdateContext != nil {
+			diags := resource.UpdateContext(ctx, d, m)
+			assert.True(t, diags.HasError(), "update of %s should fail in read-only mode", resourceName)
+		}
+		if resource.DeleteContext != nil {
+			diags := resource.DeleteContext(ctx, d, m)
+			assert.True(t, diags.HasError(), "delete of %s should fail in read-only mode", resourceName)
+		}
+	}
+}
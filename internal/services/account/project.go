@@ -9,6 +9,7 @@ import (
 	accountSDK "github.com/scaleway/scaleway-sdk-go/api/account/v3"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -61,7 +62,7 @@ func resourceAccountProjectCreate(ctx context.Context, d *schema.ResourceData, m
 	accountAPI := NewProjectAPI(m)
 
 	request := &accountSDK.ProjectAPICreateProjectRequest{
-		Name:        types.ExpandOrGenerateString(d.Get("name"), "project"),
+		Name:        meta.ExpandOrGenerateName(m, d.Get("name"), "project"),
 		Description: d.Get("description").(string),
 	}
 
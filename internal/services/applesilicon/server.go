@@ -10,8 +10,10 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
 
 func ResourceServer() *schema.Resource {
@@ -41,6 +43,23 @@ func ResourceServer() *schema.Resource {
 				Required:    true,
 				ForceNew:    true,
 			},
+			"os_id": {
+				Type:             schema.TypeString,
+				Description:      "The ID of the OS installed on the server, changing it reinstalls the server",
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: verify.IsUUID(),
+			},
+			"reinstall_trigger": {
+				Type:        schema.TypeString,
+				Description: "Any change of this value reinstalls the OS of the server",
+				Optional:    true,
+			},
+			"reboot_trigger": {
+				Type:        schema.TypeString,
+				Description: "Any change of this value reboots the server",
+				Optional:    true,
+			},
 			// Computed
 			"ip": {
 				Type:        schema.TypeString,
@@ -52,6 +71,22 @@ func ResourceServer() *schema.Resource {
 				Description: "VNC url use to connect remotely to the desktop GUI",
 				Computed:    true,
 			},
+			"ssh_username": {
+				Type:        schema.TypeString,
+				Description: "The username to use to connect to the server with SSH or VNC",
+				Computed:    true,
+			},
+			"sudo_password": {
+				Type:        schema.TypeString,
+				Description: "The password of the user, used for sudo and VNC",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"os_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the OS installed on the server",
+				Computed:    true,
+			},
 			"state": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -88,9 +123,10 @@ func ResourceAppleSiliconServerCreate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	createReq := &applesilicon.CreateServerRequest{
-		Name:      types.ExpandOrGenerateString(d.Get("name"), "m1"),
+		Name:      meta.ExpandOrGenerateName(m, d.Get("name"), "m1"),
 		Type:      d.Get("type").(string),
 		ProjectID: d.Get("project_id").(string),
+		OsID:      types.ExpandStringPtr(d.Get("os_id")),
 	}
 
 	res, err := asAPI.CreateServer(createReq, scw.WithContext(ctx))
@@ -134,6 +170,12 @@ func ResourceAppleSiliconServerRead(ctx context.Context, d *schema.ResourceData,
 	_ = d.Set("deletable_at", res.DeletableAt.Format(time.RFC3339))
 	_ = d.Set("ip", res.IP.String())
 	_ = d.Set("vnc_url", res.VncURL)
+	_ = d.Set("ssh_username", res.SSHUsername)
+	_ = d.Set("sudo_password", res.SudoPassword)
+	if res.Os != nil {
+		_ = d.Set("os_id", res.Os.ID)
+		_ = d.Set("os_name", res.Os.Name)
+	}
 
 	_ = d.Set("zone", res.Zone.String())
 	_ = d.Set("organization_id", res.OrganizationID)
@@ -148,18 +190,44 @@ func ResourceAppleSiliconServerUpdate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	req := &applesilicon.UpdateServerRequest{
-		Zone:     zone,
-		ServerID: ID,
-	}
-
 	if d.HasChange("name") {
-		req.Name = types.ExpandStringPtr(d.Get("name"))
+		_, err = asAPI.UpdateServer(&applesilicon.UpdateServerRequest{
+			Zone:     zone,
+			ServerID: ID,
+			Name:     types.ExpandStringPtr(d.Get("name")),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
-	_, err = asAPI.UpdateServer(req, scw.WithContext(ctx))
-	if err != nil {
-		return diag.FromErr(err)
+	if d.HasChanges("os_id", "reinstall_trigger") {
+		_, err = asAPI.ReinstallServer(&applesilicon.ReinstallServerRequest{
+			Zone:     zone,
+			ServerID: ID,
+			OsID:     types.ExpandStringPtr(d.Get("os_id")),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, err = waitForAppleSiliconServer(ctx, asAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else if d.HasChange("reboot_trigger") {
+		_, err = asAPI.RebootServer(&applesilicon.RebootServerRequest{
+			Zone:     zone,
+			ServerID: ID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, err = waitForAppleSiliconServer(ctx, asAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return ResourceAppleSiliconServerRead(ctx, d, m)
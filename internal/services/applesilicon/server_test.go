@@ -37,6 +37,23 @@ func TestAccServer_Basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("scaleway_apple_silicon_server.main", "vnc_url"),
 					resource.TestCheckResourceAttrSet("scaleway_apple_silicon_server.main", "created_at"),
 					resource.TestCheckResourceAttrSet("scaleway_apple_silicon_server.main", "deletable_at"),
+					resource.TestCheckResourceAttrSet("scaleway_apple_silicon_server.main", "os_id"),
+					resource.TestCheckResourceAttrSet("scaleway_apple_silicon_server.main", "ssh_username"),
+					resource.TestCheckResourceAttrSet("scaleway_apple_silicon_server.main", "sudo_password"),
+				),
+			},
+			{
+				Config: `
+					resource scaleway_apple_silicon_server main {
+						name           = "test-m1"
+						type           = "M1-M"
+						reboot_trigger = "1"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					isServerPresent(tt, "scaleway_apple_silicon_server.main"),
+					resource.TestCheckResourceAttr("scaleway_apple_silicon_server.main", "reboot_trigger", "1"),
+					resource.TestCheckResourceAttr("scaleway_apple_silicon_server.main", "state", "ready"),
 				),
 			},
 		},
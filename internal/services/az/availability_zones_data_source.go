@@ -7,8 +7,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scaleway/scaleway-sdk-go/scw"
-	"github.com/scaleway/scaleway-sdk-go/validation"
+	scwvalidation "github.com/scaleway/scaleway-sdk-go/validation"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
 )
 
@@ -27,6 +28,12 @@ func DataSourceAvailabilityZones() *schema.Resource {
 				Description: "Region is represented as a Geographical area such as France",
 				Default:     scw.RegionFrPar,
 			},
+			"product": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only list the Availability Zones in which this product is available",
+				ValidateFunc: validation.StringInSlice(products(), false),
+			},
 			"zones": {
 				Type: schema.TypeList,
 				Elem: &schema.Schema{
@@ -42,13 +49,18 @@ func DataSourceAvailabilityZones() *schema.Resource {
 func dataSourceAvailabilityZonesRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
 	regionStr := d.Get("region").(string)
 
-	if !validation.IsRegion(regionStr) {
+	if !scwvalidation.IsRegion(regionStr) {
 		return diag.FromErr(datasource.SingularDataSourceFindError("Availability Zone", fmt.Errorf("not a supported region %s", regionStr)))
 	}
 
 	region := scw.Region(regionStr)
 	d.SetId(regionStr)
-	_ = d.Set("zones", region.GetZones())
+	zones := region.GetZones()
+	if product, ok := d.GetOk("product"); ok {
+		d.SetId(regionStr + "/" + product.(string))
+		zones = productZones(product.(string), region)
+	}
+	_ = d.Set("zones", zones)
 
 	return nil
 }
@@ -44,3 +44,40 @@ func TestAccDataSourceAvailabilityZones_Basic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDataSourceAvailabilityZones_Product(t *testing.T) {
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data scaleway_availability_zones main {
+						region  = "fr-par"
+						product = "applesilicon"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.scaleway_availability_zones.main", "zones.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.scaleway_availability_zones.main", "zones.0", "fr-par-3"),
+				),
+			},
+			{
+				Config: `
+					data scaleway_availability_zones main {
+						region  = "nl-ams"
+						product = "k8s"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.scaleway_availability_zones.main", "zones.0", "nl-ams-1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,107 @@
+package az
+
+import (
+	"slices"
+	"sort"
+
+	applesiliconSDK "github.com/scaleway/scaleway-sdk-go/api/applesilicon/v1alpha1"
+	baremetalSDK "github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
+	blockSDK "github.com/scaleway/scaleway-sdk-go/api/block/v1alpha1"
+	containerSDK "github.com/scaleway/scaleway-sdk-go/api/container/v1beta1"
+	flexibleipSDK "github.com/scaleway/scaleway-sdk-go/api/flexibleip/v1alpha1"
+	functionSDK "github.com/scaleway/scaleway-sdk-go/api/function/v1beta1"
+	inferenceSDK "github.com/scaleway/scaleway-sdk-go/api/inference/v1beta1"
+	instanceSDK "github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	iotSDK "github.com/scaleway/scaleway-sdk-go/api/iot/v1"
+	jobsSDK "github.com/scaleway/scaleway-sdk-go/api/jobs/v1alpha1"
+	k8sSDK "github.com/scaleway/scaleway-sdk-go/api/k8s/v1"
+	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
+	mongodbSDK "github.com/scaleway/scaleway-sdk-go/api/mongodb/v1alpha1"
+	rdbSDK "github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
+	redisSDK "github.com/scaleway/scaleway-sdk-go/api/redis/v1"
+	registrySDK "github.com/scaleway/scaleway-sdk-go/api/registry/v1"
+	secretSDK "github.com/scaleway/scaleway-sdk-go/api/secret/v1beta1"
+	temSDK "github.com/scaleway/scaleway-sdk-go/api/tem/v1alpha1"
+	vpcSDK "github.com/scaleway/scaleway-sdk-go/api/vpc/v2"
+	vpcgwSDK "github.com/scaleway/scaleway-sdk-go/api/vpcgw/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+// zonedProducts are the products available by zone, with the zones they are available in.
+// The localities are static values of the SDK, listing them does not call any API.
+var zonedProducts = map[string][]scw.Zone{
+	"applesilicon": applesiliconSDK.NewAPI(nil).Zones(),
+	"baremetal":    baremetalSDK.NewAPI(nil).Zones(),
+	"block":        blockSDK.NewAPI(nil).Zones(),
+	"flexibleip":   flexibleipSDK.NewAPI(nil).Zones(),
+	"instance":     instanceSDK.NewAPI(nil).Zones(),
+	"lb":           lbSDK.NewZonedAPI(nil).Zones(),
+	"redis":        redisSDK.NewAPI(nil).Zones(),
+	"vpcgw":        vpcgwSDK.NewAPI(nil).Zones(),
+}
+
+// regionalProducts are the products available by region, with the regions they are available in.
+var regionalProducts = map[string][]scw.Region{
+	"container": containerSDK.NewAPI(nil).Regions(),
+	"function":  functionSDK.NewAPI(nil).Regions(),
+	"inference": inferenceSDK.NewAPI(nil).Regions(),
+	"iot":       iotSDK.NewAPI(nil).Regions(),
+	"jobs":      jobsSDK.NewAPI(nil).Regions(),
+	"k8s":       k8sSDK.NewAPI(nil).Regions(),
+	"mongodb":   mongodbSDK.NewAPI(nil).Regions(),
+	"rdb":       rdbSDK.NewAPI(nil).Regions(),
+	"registry":  registrySDK.NewAPI(nil).Regions(),
+	"secret":    secretSDK.NewAPI(nil).Regions(),
+	"tem":       temSDK.NewAPI(nil).Regions(),
+	"vpc":       vpcSDK.NewAPI(nil).Regions(),
+}
+
+// products returns the names of all the products with a known availability
+func products() []string {
+	names := make([]string, 0, len(zonedProducts)+len(regionalProducts))
+	for name := range zonedProducts {
+		names = append(names, name)
+	}
+	for name := range regionalProducts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// productZones returns the zones of the region in which the product is available.
+// A regional product is available in all the zones of its regions.
+func productZones(product string, region scw.Region) []scw.Zone {
+	if regions, ok := regionalProducts[product]; ok {
+		if slices.Contains(regions, region) {
+			return region.GetZones()
+		}
+		return []scw.Zone{}
+	}
+
+	zones := []scw.Zone{}
+	for _, zone := range region.GetZones() {
+		if slices.Contains(zonedProducts[product], zone) {
+			zones = append(zones, zone)
+		}
+	}
+
+	return zones
+}
+
+// productRegions returns the regions in which the product is available
+func productRegions(product string) []scw.Region {
+	if regions, ok := regionalProducts[product]; ok {
+		return regions
+	}
+
+	regions := []scw.Region{}
+	for _, region := range scw.AllRegions {
+		if len(productZones(product, region)) > 0 {
+			regions = append(regions, region)
+		}
+	}
+
+	return regions
+}
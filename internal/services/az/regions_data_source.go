@@ -0,0 +1,46 @@
+package az
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func DataSourceRegions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRegionsRead,
+		Schema: map[string]*schema.Schema{
+			"product": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only list the regions in which this product is available",
+				ValidateFunc: validation.StringInSlice(products(), false),
+			},
+			"regions": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed:    true,
+				Description: "The regions",
+			},
+		},
+	}
+}
+
+func dataSourceRegionsRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	regions := scw.AllRegions
+	d.SetId("all")
+
+	if product, ok := d.GetOk("product"); ok {
+		regions = productRegions(product.(string))
+		d.SetId(product.(string))
+	}
+
+	_ = d.Set("regions", regions)
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package az_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccDataSourceRegions_Basic(t *testing.T) {
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data scaleway_regions all {
+					}
+
+					data scaleway_regions applesilicon {
+						product = "applesilicon"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_regions.all", "regions.0", "fr-par"),
+					resource.TestCheckResourceAttr("data.scaleway_regions.applesilicon", "regions.#", "1"),
+					resource.TestCheckResourceAttr("data.scaleway_regions.applesilicon", "regions.0", "fr-par"),
+				),
+			},
+		},
+	})
+}
@@ -5,12 +5,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"sort"
 	"time"
 
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
 	baremetalV3 "github.com/scaleway/scaleway-sdk-go/api/baremetal/v3"
+	ipamSDK "github.com/scaleway/scaleway-sdk-go/api/ipam/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
@@ -212,3 +215,89 @@ func privateNetworkSetHash(v interface{}) int {
 
 	return schema.HashString(buf.String())
 }
+
+// getPrivateNetworkIPAddresses returns the addresses of the IPAM IPs of the server private networks, indexed by IPAM IP ID.
+func getPrivateNetworkIPAddresses(ctx context.Context, m interface{}, region scw.Region, privateNetworks []*baremetalV3.ServerPrivateNetwork) (map[string]string, error) {
+	ipamAPI := ipamSDK.NewAPI(meta.ExtractScwClient(m))
+	addresses := make(map[string]string)
+
+	for _, privateNetwork := range privateNetworks {
+		for _, ipamIPID := range privateNetwork.IpamIPIDs {
+			ip, err := ipamAPI.GetIP(&ipamSDK.GetIPRequest{
+				Region: region,
+				IPID:   ipamIPID,
+			}, scw.WithContext(ctx))
+			if err != nil {
+				if httperrors.Is404(err) {
+					continue
+				}
+				return nil, err
+			}
+			address, err := types.FlattenIPNet(ip.Address)
+			if err != nil {
+				return nil, err
+			}
+			addresses[ipamIPID] = address
+		}
+	}
+
+	return addresses, nil
+}
+
+var osVersionRegex = regexp.MustCompile(`^\d+(\.\d+)*`)
+
+// parseOSVersion parses the leading numeric part of an os version like "22.04 LTS (Jammy Jellyfish)"
+func parseOSVersion(rawVersion string) (*version.Version, error) {
+	numericVersion := osVersionRegex.FindString(rawVersion)
+	if numericVersion == "" {
+		return nil, fmt.Errorf("os version %q does not start with a version number", rawVersion)
+	}
+
+	return version.NewVersion(numericVersion)
+}
+
+// findLatestOSMatchingConstraint returns the os with the given name and the highest version matching the constraint
+func findLatestOSMatchingConstraint(oses []*baremetal.OS, name string, rawConstraint string) (*baremetal.OS, error) {
+	constraint, err := version.NewConstraint(rawConstraint)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		latestOS      *baremetal.OS
+		latestVersion *version.Version
+	)
+
+	for _, os := range oses {
+		if os.Name != name {
+			continue
+		}
+
+		osVersion, err := parseOSVersion(os.Version)
+		if err != nil || !constraint.Check(osVersion) {
+			continue
+		}
+
+		if latestVersion == nil || osVersion.GreaterThan(latestVersion) {
+			latestOS, latestVersion = os, osVersion
+		}
+	}
+
+	if latestOS == nil {
+		return nil, fmt.Errorf("no os found with the name %s and a version matching %q", name, rawConstraint)
+	}
+
+	return latestOS, nil
+}
+
+// listOSVersions returns the versions of the oses with the given name
+func listOSVersions(oses []*baremetal.OS, name string) []string {
+	versions := []string(nil)
+	for _, os := range oses {
+		if os.Name == name {
+			versions = append(versions, os.Version)
+		}
+	}
+
+	return versions
+}
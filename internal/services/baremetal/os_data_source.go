@@ -10,6 +10,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
 
@@ -27,7 +28,30 @@ func DataSourceOS() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				Description:   "Version string of the desired OS",
-				ConflictsWith: []string{"os_id"},
+				ConflictsWith: []string{"os_id", "version_constraint"},
+			},
+			"version_constraint": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Version constraint of the desired OS (e.g. \">= 22.04, < 24\"), the latest matching version is selected",
+				ValidateDiagFunc: verify.IsVersionConstraint(),
+				ConflictsWith:    []string{"os_id", "version"},
+				RequiredWith:     []string{"name"},
+			},
+			"offer_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only select an OS available for this offer",
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+				ConflictsWith:    []string{"os_id"},
+			},
+			"available_versions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The versions available for this OS name, and for the offer if set",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
 			},
 			"os_id": {
 				Type:             schema.TypeString,
@@ -63,21 +87,37 @@ func DataSourceOSRead(ctx context.Context, d *schema.ResourceData, m interface{}
 		osName = res.Name
 	} else {
 		// Get server by zone and name.
-		res, err := api.ListOS(&baremetal.ListOSRequest{
+		req := &baremetal.ListOSRequest{
 			Zone: zone,
-		}, scw.WithAllPages(), scw.WithContext(ctx))
+		}
+		if offerID, ok := d.GetOk("offer_id"); ok {
+			req.OfferID = types.ExpandStringPtr(zonal.ExpandID(offerID).ID)
+		}
+
+		res, err := api.ListOS(req, scw.WithAllPages(), scw.WithContext(ctx))
 		if err != nil {
 			return diag.FromErr(err)
 		}
 		if len(res.Os) == 0 {
 			return diag.FromErr(fmt.Errorf("no os found with the name %s", d.Get("name")))
 		}
-		for _, os := range res.Os {
-			if os.Name == d.Get("name") && os.Version == d.Get("version") {
-				osID, osVersion, osName = os.ID, os.Version, os.Name
-				break
+
+		if constraint, ok := d.GetOk("version_constraint"); ok {
+			os, err := findLatestOSMatchingConstraint(res.Os, d.Get("name").(string), constraint.(string))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			osID, osVersion, osName = os.ID, os.Version, os.Name
+		} else {
+			for _, os := range res.Os {
+				if os.Name == d.Get("name") && os.Version == d.Get("version") {
+					osID, osVersion, osName = os.ID, os.Version, os.Name
+					break
+				}
 			}
 		}
+
+		_ = d.Set("available_versions", listOSVersions(res.Os, d.Get("name").(string)))
 	}
 
 	zoneID := datasource.NewZonedID(osID, zone)
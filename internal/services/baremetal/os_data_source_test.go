@@ -49,6 +49,33 @@ func TestAccDataSourceOS_Basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceOS_VersionConstraint(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "scaleway_baremetal_os" "latest_ubuntu" {
+						name = "Ubuntu"
+						version_constraint = ">= 20.04, < 24"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaremetalOsExists(tt, "data.scaleway_baremetal_os.latest_ubuntu"),
+					resource.TestCheckResourceAttr("data.scaleway_baremetal_os.latest_ubuntu", "version", "22.04 LTS (Jammy Jellyfish)"),
+					resource.TestCheckResourceAttrSet("data.scaleway_baremetal_os.latest_ubuntu", "available_versions.#"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckBaremetalOsExists(tt *acctest.TestTools, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
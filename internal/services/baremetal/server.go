@@ -18,6 +18,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -125,12 +126,24 @@ If this behaviour is wanted, please set 'reinstall_on_ssh_key_changes' argument
 				Sensitive:   true,
 				Description: "Password used for the service to install.",
 			},
+			"partitioning": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "The partitioning schema of the server in JSON format, the default schema of the offer and OS is used if not set",
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: dsf.EquivalentJSON,
+			},
 			"reinstall_on_config_changes": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     false,
 				Description: "If True, this boolean allows to reinstall the server on SSH key IDs, user or password changes",
 			},
+			"reinstall_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Any change of this value reinstalls the server with the current install config",
+			},
 			"install_config_afterward": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -232,6 +245,12 @@ If this behaviour is wanted, please set 'reinstall_on_ssh_key_changes' argument
 							Description: "List of IPAM IP IDs to attach to the server",
 						},
 						// computed
+						"ip_addresses": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The IP addresses of the server in the private network, in CIDR notation",
+						},
 						"vlan": {
 							Type:        schema.TypeInt,
 							Computed:    true,
@@ -313,15 +332,34 @@ func ResourceServerCreate(ctx context.Context, d *schema.ResourceData, m interfa
 		offerID = zonal.NewID(zone, o.ID)
 	}
 
+	var partitioningSchema *baremetal.Schema
+	if rawPartitioning, ok := d.GetOk("partitioning"); ok {
+		partitioningSchema, err = expandPartitioningSchema(rawPartitioning.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if !d.Get("install_config_afterward").(bool) {
 		if diags := validateInstallConfig(ctx, d, m); len(diags) > 0 {
 			return diags
 		}
+		if partitioningSchema != nil {
+			err = api.ValidatePartitioningSchema(&baremetal.ValidatePartitioningSchemaRequest{
+				Zone:               zone,
+				OfferID:            offerID.ID,
+				OsID:               zonal.ExpandID(d.Get("os")).ID,
+				PartitioningSchema: partitioningSchema,
+			}, scw.WithContext(ctx))
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("invalid partitioning schema: %w", err))
+			}
+		}
 	}
 
 	server, err := api.CreateServer(&baremetal.CreateServerRequest{
 		Zone:        zone,
-		Name:        types.ExpandOrGenerateString(d.Get("name"), "bm"),
+		Name:        meta.ExpandOrGenerateName(m, d.Get("name"), "bm"),
 		ProjectID:   types.ExpandStringPtr(d.Get("project_id")),
 		Description: d.Get("description").(string),
 		OfferID:     offerID.ID,
@@ -340,15 +378,16 @@ func ResourceServerCreate(ctx context.Context, d *schema.ResourceData, m interfa
 
 	if !d.Get("install_config_afterward").(bool) {
 		_, err = api.InstallServer(&baremetal.InstallServerRequest{
-			Zone:            server.Zone,
-			ServerID:        server.ID,
-			OsID:            zonal.ExpandID(d.Get("os")).ID,
-			Hostname:        types.ExpandStringWithDefault(d.Get("hostname"), server.Name),
-			SSHKeyIDs:       types.ExpandStrings(d.Get("ssh_key_ids")),
-			User:            types.ExpandStringPtr(d.Get("user")),
-			Password:        types.ExpandStringPtr(d.Get("password")),
-			ServiceUser:     types.ExpandStringPtr(d.Get("service_user")),
-			ServicePassword: types.ExpandStringPtr(d.Get("service_password")),
+			Zone:               server.Zone,
+			ServerID:           server.ID,
+			OsID:               zonal.ExpandID(d.Get("os")).ID,
+			Hostname:           types.ExpandStringWithDefault(d.Get("hostname"), server.Name),
+			SSHKeyIDs:          types.ExpandStrings(d.Get("ssh_key_ids")),
+			User:               types.ExpandStringPtr(d.Get("user")),
+			Password:           types.ExpandStringPtr(d.Get("password")),
+			ServiceUser:        types.ExpandStringPtr(d.Get("service_user")),
+			ServicePassword:    types.ExpandStringPtr(d.Get("service_password")),
+			PartitioningSchema: partitioningSchema,
 		}, scw.WithContext(ctx))
 		if err != nil {
 			return diag.FromErr(err)
@@ -479,7 +518,11 @@ func ResourceServerRead(ctx context.Context, d *schema.ResourceData, m interface
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	_ = d.Set("private_network", flattenPrivateNetworks(pnRegion, listPrivateNetworks.ServerPrivateNetworks))
+	ipAddresses, err := getPrivateNetworkIPAddresses(ctx, m, pnRegion, listPrivateNetworks.ServerPrivateNetworks)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to get the IP addresses of the server's private networks: %w", err))
+	}
+	_ = d.Set("private_network", flattenPrivateNetworks(pnRegion, listPrivateNetworks.ServerPrivateNetworks, ipAddresses))
 
 	return nil
 }
@@ -606,6 +649,13 @@ func ResourceServerUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 		ServicePassword: types.ExpandStringPtr(d.Get("service_password")),
 	}
 
+	if rawPartitioning, ok := d.GetOk("partitioning"); ok {
+		installReq.PartitioningSchema, err = expandPartitioningSchema(rawPartitioning.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.HasChange("os") {
 		if diags := validateInstallConfig(ctx, d, m); len(diags) > 0 {
 			return diags
@@ -623,8 +673,8 @@ func ResourceServerUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 
 	var diags diag.Diagnostics
 
-	if d.HasChanges("ssh_key_ids", "user", "password", "reinstall_on_config_changes") {
-		if !d.Get("reinstall_on_config_changes").(bool) && !d.HasChange("os") {
+	if d.HasChanges("ssh_key_ids", "user", "password", "partitioning", "reinstall_on_config_changes", "reinstall_trigger") {
+		if !d.Get("reinstall_on_config_changes").(bool) && !d.HasChanges("os", "reinstall_trigger") {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Warning,
 				Summary:  "Changes have been made on your config",
@@ -139,6 +139,124 @@ func TestAccServer_RequiredInstallConfig(t *testing.T) {
 	})
 }
 
+func TestAccServer_InvalidPartitioning(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	if !IsOfferAvailable(OfferID, Zone, tt) {
+		t.Skip("Offer is out of stock")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      baremetalchecks.CheckServerDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "scaleway_baremetal_os" "my_os" {
+					  zone    = "fr-par-1"
+					  name    = "Ubuntu"
+					  version = "22.04 LTS (Jammy Jellyfish)"
+					}
+
+					resource "scaleway_iam_ssh_key" "main" {
+						name 	   = "TestAccServer_InvalidPartitioning"
+						public_key = "%s"
+					}
+
+					resource "scaleway_baremetal_server" "base" {
+						name        = "TestAccServer_InvalidPartitioning"
+						zone        = "fr-par-1"
+						offer       = "%s"
+						os          = data.scaleway_baremetal_os.my_os.os_id
+						ssh_key_ids = [ scaleway_iam_ssh_key.main.id ]
+
+						partitioning = jsonencode({
+						  disks = [{
+							device     = "/dev/does-not-exist"
+							partitions = [{ label = "root", number = 1, size = 0 }]
+						  }]
+						  filesystems = [{ device = "/dev/does-not-exist1", format = "ext4", mountpoint = "/" }]
+						})
+					}`, SSHKeyBaremetal, OfferName),
+				ExpectError: regexp.MustCompile("invalid partitioning schema"),
+			},
+		},
+	})
+}
+
+func TestAccServer_ReinstallTrigger(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	if !IsOfferAvailable(OfferID, Zone, tt) {
+		t.Skip("Offer is out of stock")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      baremetalchecks.CheckServerDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "scaleway_baremetal_os" "my_os" {
+					  zone    = "fr-par-1"
+					  name    = "Ubuntu"
+					  version = "22.04 LTS (Jammy Jellyfish)"
+					}
+
+					resource "scaleway_iam_ssh_key" "main" {
+						name 	   = "TestAccServer_ReinstallTrigger"
+						public_key = "%s"
+					}
+
+					resource "scaleway_baremetal_server" "base" {
+						name        = "TestAccServer_ReinstallTrigger"
+						zone        = "fr-par-1"
+						offer       = "%s"
+						os          = data.scaleway_baremetal_os.my_os.os_id
+						ssh_key_ids = [ scaleway_iam_ssh_key.main.id ]
+
+						reinstall_trigger = "%s"
+					}`, SSHKeyBaremetal, OfferName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaremetalServerExists(tt, "scaleway_baremetal_server.base"),
+					resource.TestCheckResourceAttr("scaleway_baremetal_server.base", "reinstall_trigger", "first"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "scaleway_baremetal_os" "my_os" {
+					  zone    = "fr-par-1"
+					  name    = "Ubuntu"
+					  version = "22.04 LTS (Jammy Jellyfish)"
+					}
+
+					resource "scaleway_iam_ssh_key" "main" {
+						name 	   = "TestAccServer_ReinstallTrigger"
+						public_key = "%s"
+					}
+
+					resource "scaleway_baremetal_server" "base" {
+						name        = "TestAccServer_ReinstallTrigger"
+						zone        = "fr-par-1"
+						offer       = "%s"
+						os          = data.scaleway_baremetal_os.my_os.os_id
+						ssh_key_ids = [ scaleway_iam_ssh_key.main.id ]
+
+						reinstall_trigger = "%s"
+					}`, SSHKeyBaremetal, OfferName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaremetalServerExists(tt, "scaleway_baremetal_server.base"),
+					resource.TestCheckResourceAttr("scaleway_baremetal_server.base", "reinstall_trigger", "second"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccServer_WithoutInstallConfig(t *testing.T) {
 	tt := acctest.NewTestTools(t)
 	defer tt.Cleanup()
@@ -882,6 +1000,7 @@ func TestAccServer_WithIPAMPrivateNetwork(t *testing.T) {
 					testAccCheckBaremetalServerExists(tt, "scaleway_baremetal_server.base"),
 					testAccCheckBaremetalServerHasPrivateNetwork(tt, "scaleway_baremetal_server.base"),
 					resource.TestCheckResourceAttrPair("scaleway_ipam_ip.ip01", "address", "data.scaleway_ipam_ip.base", "address_cidr"),
+					resource.TestCheckResourceAttrPair("scaleway_ipam_ip.ip01", "address", "scaleway_baremetal_server.base", "private_network.0.ip_addresses.0"),
 				),
 			},
 			{
@@ -1,6 +1,9 @@
 package baremetal
 
 import (
+	"encoding/json"
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
 	baremetalV3 "github.com/scaleway/scaleway-sdk-go/api/baremetal/v3"
@@ -55,6 +58,16 @@ func expandPrivateNetworks(pn interface{}) map[string]*[]string {
 	return privateNetworks
 }
 
+func expandPartitioningSchema(rawSchema string) (*baremetal.Schema, error) {
+	partitioningSchema := &baremetal.Schema{}
+	err := json.Unmarshal([]byte(rawSchema), partitioningSchema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse partitioning schema: %w", err)
+	}
+
+	return partitioningSchema, nil
+}
+
 func flattenCPUs(cpus []*baremetal.CPU) interface{} {
 	if cpus == nil {
 		return nil
@@ -168,16 +181,23 @@ func flattenOptions(zone scw.Zone, options []*baremetal.ServerOption) interface{
 	return flattenedOptions
 }
 
-func flattenPrivateNetworks(region scw.Region, privateNetworks []*baremetalV3.ServerPrivateNetwork) interface{} {
+func flattenPrivateNetworks(region scw.Region, privateNetworks []*baremetalV3.ServerPrivateNetwork, ipAddresses map[string]string) interface{} {
 	flattenedPrivateNetworks := []map[string]interface{}(nil)
 	for _, privateNetwork := range privateNetworks {
+		addresses := []string(nil)
+		for _, ipamIPID := range privateNetwork.IpamIPIDs {
+			if address, ok := ipAddresses[ipamIPID]; ok {
+				addresses = append(addresses, address)
+			}
+		}
 		flattenedPrivateNetworks = append(flattenedPrivateNetworks, map[string]interface{}{
-			"id":          regional.NewIDString(region, privateNetwork.PrivateNetworkID),
-			"ipam_ip_ids": regional.NewRegionalIDs(region, privateNetwork.IpamIPIDs),
-			"vlan":        types.FlattenUint32Ptr(privateNetwork.Vlan),
-			"status":      privateNetwork.Status,
-			"created_at":  types.FlattenTime(privateNetwork.CreatedAt),
-			"updated_at":  types.FlattenTime(privateNetwork.UpdatedAt),
+			"id":           regional.NewIDString(region, privateNetwork.PrivateNetworkID),
+			"ipam_ip_ids":  regional.NewRegionalIDs(region, privateNetwork.IpamIPIDs),
+			"ip_addresses": addresses,
+			"vlan":         types.FlattenUint32Ptr(privateNetwork.Vlan),
+			"status":       privateNetwork.Status,
+			"created_at":   types.FlattenTime(privateNetwork.CreatedAt),
+			"updated_at":   types.FlattenTime(privateNetwork.UpdatedAt),
 		})
 	}
 	return flattenedPrivateNetworks
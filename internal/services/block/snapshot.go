@@ -11,6 +11,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -69,7 +70,7 @@ func ResourceBlockSnapshotCreate(ctx context.Context, d *schema.ResourceData, m
 	snapshot, err := api.CreateSnapshot(&block.CreateSnapshotRequest{
 		Zone:      zone,
 		ProjectID: d.Get("project_id").(string),
-		Name:      types.ExpandOrGenerateString(d.Get("name").(string), "snapshot"),
+		Name:      meta.ExpandOrGenerateName(m, d.Get("name").(string), "snapshot"),
 		VolumeID:  locality.ExpandID(d.Get("volume_id")),
 		Tags:      types.ExpandStrings(d.Get("tags")),
 	}, scw.WithContext(ctx))
@@ -12,6 +12,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
@@ -85,7 +86,7 @@ func ResourceBlockVolumeCreate(ctx context.Context, d *schema.ResourceData, m in
 
 	req := &block.CreateVolumeRequest{
 		Zone:      zone,
-		Name:      types.ExpandOrGenerateString(d.Get("name").(string), "volume"),
+		Name:      meta.ExpandOrGenerateName(m, d.Get("name").(string), "volume"),
 		ProjectID: d.Get("project_id").(string),
 		Tags:      types.ExpandStrings(d.Get("tags")),
 		PerfIops:  types.ExpandUint32Ptr(d.Get("iops")),
@@ -0,0 +1,266 @@
+package cockpit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+)
+
+func ResourceCockpitGrafanaDashboard() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceCockpitGrafanaDashboardCreate,
+		ReadContext:   ResourceCockpitGrafanaDashboardRead,
+		UpdateContext: ResourceCockpitGrafanaDashboardUpdate,
+		DeleteContext: ResourceCockpitGrafanaDashboardDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: ResourceCockpitGrafanaDashboardImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(DefaultCockpitTimeout),
+			Read:    schema.DefaultTimeout(DefaultCockpitTimeout),
+			Update:  schema.DefaultTimeout(DefaultCockpitTimeout),
+			Delete:  schema.DefaultTimeout(DefaultCockpitTimeout),
+			Default: schema.DefaultTimeout(DefaultCockpitTimeout),
+		},
+		Schema: map[string]*schema.Schema{
+			"config_json": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The JSON model of the dashboard",
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: dsf.EquivalentJSON,
+			},
+			"folder": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The title of the Grafana folder of the dashboard, created if it does not exist",
+			},
+			"login": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The login of a Grafana user with the editor role used to manage the dashboard",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The password of the Grafana user",
+			},
+			"uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the dashboard in Grafana",
+			},
+			"folder_uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the Grafana folder of the dashboard",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the dashboard",
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version of the dashboard in Grafana",
+			},
+			"project_id": account.ProjectIDSchema(),
+		},
+	}
+}
+
+func ResourceCockpitGrafanaDashboardCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, projectID, err := grafanaClientWithProjectID(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := saveGrafanaDashboard(ctx, d, client, "")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(cockpitIDWithProjectID(projectID, res.UID))
+
+	return ResourceCockpitGrafanaDashboardRead(ctx, d, m)
+}
+
+func ResourceCockpitGrafanaDashboardRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	projectID, uid, err := parseCockpitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("login").(string) == "" {
+		// An imported dashboard is read once the Grafana user is set by the next apply
+		_ = d.Set("uid", uid)
+		_ = d.Set("project_id", projectID)
+
+		return nil
+	}
+
+	client, _, err := grafanaClientWithProjectID(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dashboard, err := client.getDashboard(ctx, uid)
+	if err != nil {
+		if errors.Is(err, errGrafanaNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	configJSON, err := flattenGrafanaDashboardConfig(dashboard, grafanaDashboardConfigHasUID(d.Get("config_json").(string)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("config_json", configJSON)
+	_ = d.Set("uid", dashboard.uid())
+	_ = d.Set("version", dashboard.version())
+	_ = d.Set("url", client.url+dashboard.Meta.URL)
+	_ = d.Set("folder_uid", dashboard.Meta.FolderUID)
+	if _, ok := d.GetOk("folder"); ok {
+		_ = d.Set("folder", dashboard.Meta.FolderTitle)
+	}
+	_ = d.Set("project_id", projectID)
+
+	return nil
+}
+
+func ResourceCockpitGrafanaDashboardUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, projectID, err := grafanaClientWithProjectID(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, uid, err := parseCockpitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("config_json", "folder") {
+		res, err := saveGrafanaDashboard(ctx, d, client, uid)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// A new uid in config_json creates a new dashboard, the previous one must be removed
+		if res.UID != uid {
+			err = client.deleteDashboard(ctx, uid)
+			if err != nil && !errors.Is(err, errGrafanaNotFound) {
+				return diag.FromErr(err)
+			}
+			d.SetId(cockpitIDWithProjectID(projectID, res.UID))
+		}
+	}
+
+	return ResourceCockpitGrafanaDashboardRead(ctx, d, m)
+}
+
+func ResourceCockpitGrafanaDashboardDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, _, err := grafanaClientWithProjectID(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, uid, err := parseCockpitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.deleteDashboard(ctx, uid)
+	if err != nil && !errors.Is(err, errGrafanaNotFound) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// ResourceCockpitGrafanaDashboardImport imports a dashboard with an ID in the {project_id}/{uid} format.
+// The Grafana user is not known at import time, the dashboard is read and overwritten by the next apply.
+func ResourceCockpitGrafanaDashboardImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	projectID, uid, err := parseCockpitID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if projectID == "" || uid == "" {
+		return nil, fmt.Errorf("invalid dashboard ID %q, expected {project_id}/{uid}", d.Id())
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// grafanaDashboardConfigHasUID returns whether the uid of the dashboard is set in config_json
+func grafanaDashboardConfigHasUID(configJSON string) bool {
+	config := map[string]interface{}{}
+	_ = json.Unmarshal([]byte(configJSON), &config)
+	uid, _ := config["uid"].(string)
+
+	return uid != ""
+}
+
+// grafanaClientWithProjectID returns a Grafana client authenticated with the Grafana user of the resource.
+func grafanaClientWithProjectID(ctx context.Context, d *schema.ResourceData, m interface{}) (*grafanaClient, string, error) {
+	api, err := NewGlobalAPI(m)
+	if err != nil {
+		return nil, "", err
+	}
+
+	projectID := d.Get("project_id").(string)
+	if d.Id() != "" {
+		projectID, _, err = parseCockpitID(d.Id())
+		if err != nil {
+			return nil, "", err
+		}
+	} else if projectID == "" {
+		projectID, err = getDefaultProjectID(ctx, m)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	client, err := newGrafanaClient(ctx, m, api, projectID, d.Get("login").(string), d.Get("password").(string))
+	if err != nil {
+		return nil, "", err
+	}
+
+	return client, projectID, nil
+}
+
+// saveGrafanaDashboard pushes config_json in the folder of the resource.
+// The uid of config_json takes precedence over the given uid.
+func saveGrafanaDashboard(ctx context.Context, d *schema.ResourceData, client *grafanaClient, uid string) (*grafanaSaveDashboardResponse, error) {
+	dashboard := map[string]interface{}{}
+
+	err := json.Unmarshal([]byte(d.Get("config_json").(string)), &dashboard)
+	if err != nil {
+		return nil, fmt.Errorf("config_json is an invalid JSON: %w", err)
+	}
+
+	if configUID, ok := dashboard["uid"].(string); ok && configUID != "" {
+		uid = configUID
+	}
+
+	folderUID := ""
+	if folder, ok := d.GetOk("folder"); ok {
+		folderUID, err = client.findOrCreateFolder(ctx, folder.(string))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return client.saveDashboard(ctx, dashboard, uid, folderUID)
+}
@@ -0,0 +1,76 @@
+package cockpit_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccGrafanaDashboard_Basic(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	projectName := "tf_tests_cockpit_grafana_dashboard_basic"
+
+	config := func(title string, folder string) string {
+		return fmt.Sprintf(`
+			resource "scaleway_account_project" "project" {
+				name = "%[1]s"
+			}
+
+			resource "scaleway_cockpit_grafana_user" "main" {
+				project_id = scaleway_account_project.project.id
+				login      = "testdashboard"
+				role       = "editor"
+			}
+
+			resource "scaleway_cockpit_grafana_dashboard" "main" {
+				project_id  = scaleway_account_project.project.id
+				login       = scaleway_cockpit_grafana_user.main.login
+				password    = scaleway_cockpit_grafana_user.main.password
+				folder      = "%[3]s"
+				config_json = jsonencode({
+					title  = "%[2]s"
+					panels = []
+				})
+			}
+		`, projectName, title, folder)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      isGrafanaUserDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: config("tf-test-dashboard", "tf-test-folder"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("scaleway_cockpit_grafana_dashboard.main", "project_id", "scaleway_account_project.project", "id"),
+					resource.TestCheckResourceAttr("scaleway_cockpit_grafana_dashboard.main", "folder", "tf-test-folder"),
+					resource.TestCheckResourceAttrSet("scaleway_cockpit_grafana_dashboard.main", "uid"),
+					resource.TestCheckResourceAttrSet("scaleway_cockpit_grafana_dashboard.main", "folder_uid"),
+					resource.TestCheckResourceAttrSet("scaleway_cockpit_grafana_dashboard.main", "url"),
+					resource.TestCheckResourceAttr("scaleway_cockpit_grafana_dashboard.main", "version", "1"),
+				),
+			},
+			{
+				Config: config("tf-test-dashboard-updated", "tf-test-folder-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scaleway_cockpit_grafana_dashboard.main", "folder", "tf-test-folder-updated"),
+					resource.TestCheckResourceAttr("scaleway_cockpit_grafana_dashboard.main", "version", "2"),
+				),
+			},
+			{
+				ResourceName:      "scaleway_cockpit_grafana_dashboard.main",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The dashboard is read from Grafana once the Grafana user is set by the next apply
+				ImportStateVerifyIgnore: []string{"config_json", "login", "password", "folder", "folder_uid", "url", "version"},
+			},
+		},
+	})
+}
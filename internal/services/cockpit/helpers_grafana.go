@@ -0,0 +1,208 @@
+package cockpit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/scaleway/scaleway-sdk-go/api/cockpit/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+)
+
+var errGrafanaNotFound = errors.New("grafana resource not found")
+
+// grafanaClient is a minimal client of the HTTP API of the Grafana of a Cockpit, authenticated with a Grafana user.
+type grafanaClient struct {
+	httpClient *http.Client
+	url        string
+	login      string
+	password   string
+}
+
+func newGrafanaClient(ctx context.Context, m interface{}, api *cockpit.GlobalAPI, projectID string, login string, password string) (*grafanaClient, error) {
+	grafana, err := api.GetGrafana(&cockpit.GlobalAPIGetGrafanaRequest{
+		ProjectID: projectID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &grafanaClient{
+		httpClient: meta.ExtractHTTPClient(m),
+		url:        strings.TrimSuffix(grafana.GrafanaURL, "/"),
+		login:      login,
+		password:   password,
+	}, nil
+}
+
+// do sends a request to the Grafana API and decodes the JSON response in out if not nil.
+// errGrafanaNotFound is returned when the API answers with a 404.
+func (c *grafanaClient) do(ctx context.Context, method string, path string, body interface{}, out interface{}) error {
+	var reqBody io.Reader
+
+	if body != nil {
+		rawBody, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(rawBody)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.url+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.login, c.password)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return errGrafanaNotFound
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		message, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("grafana %s %s: unexpected status %s: %s", method, path, resp.Status, strings.TrimSpace(string(message)))
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+type grafanaFolder struct {
+	UID   string `json:"uid"`
+	Title string `json:"title"`
+}
+
+// findOrCreateFolder returns the UID of the Grafana folder with the given title, creating it if it does not exist.
+func (c *grafanaClient) findOrCreateFolder(ctx context.Context, title string) (string, error) {
+	folders := []*grafanaFolder(nil)
+
+	err := c.do(ctx, http.MethodGet, "/api/folders", nil, &folders)
+	if err != nil {
+		return "", err
+	}
+
+	for _, folder := range folders {
+		if folder.Title == title {
+			return folder.UID, nil
+		}
+	}
+
+	folder := &grafanaFolder{}
+
+	err = c.do(ctx, http.MethodPost, "/api/folders", map[string]string{"title": title}, folder)
+	if err != nil {
+		return "", err
+	}
+
+	return folder.UID, nil
+}
+
+type grafanaSaveDashboardResponse struct {
+	UID     string `json:"uid"`
+	URL     string `json:"url"`
+	Version int    `json:"version"`
+}
+
+// saveDashboard creates or overwrites a dashboard, the uid of the dashboard is used when not empty.
+func (c *grafanaClient) saveDashboard(ctx context.Context, dashboard map[string]interface{}, uid string, folderUID string) (*grafanaSaveDashboardResponse, error) {
+	// The numeric id is specific to a Grafana instance, dashboards are identified by their uid
+	delete(dashboard, "id")
+	if uid != "" {
+		dashboard["uid"] = uid
+	}
+
+	res := &grafanaSaveDashboardResponse{}
+
+	err := c.do(ctx, http.MethodPost, "/api/dashboards/db", map[string]interface{}{
+		"dashboard": dashboard,
+		"folderUid": folderUID,
+		"overwrite": true,
+		"message":   "Updated by Terraform",
+	}, res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+type grafanaDashboard struct {
+	// Dashboard is the JSON model of the dashboard
+	Dashboard map[string]interface{} `json:"dashboard"`
+	Meta      struct {
+		URL         string `json:"url"`
+		FolderUID   string `json:"folderUid"`
+		FolderTitle string `json:"folderTitle"`
+	} `json:"meta"`
+}
+
+func (c *grafanaClient) getDashboard(ctx context.Context, uid string) (*grafanaDashboard, error) {
+	dashboard := &grafanaDashboard{}
+
+	err := c.do(ctx, http.MethodGet, "/api/dashboards/uid/"+url.PathEscape(uid), nil, dashboard)
+	if err != nil {
+		return nil, err
+	}
+
+	return dashboard, nil
+}
+
+func (c *grafanaClient) deleteDashboard(ctx context.Context, uid string) error {
+	return c.do(ctx, http.MethodDelete, "/api/dashboards/uid/"+url.PathEscape(uid), nil, nil)
+}
+
+// uid returns the uid of the JSON model of the dashboard
+func (d *grafanaDashboard) uid() string {
+	uid, _ := d.Dashboard["uid"].(string)
+
+	return uid
+}
+
+// version returns the version of the JSON model of the dashboard
+func (d *grafanaDashboard) version() int {
+	version, _ := d.Dashboard["version"].(float64)
+
+	return int(version)
+}
+
+// flattenGrafanaDashboardConfig returns the JSON model of the dashboard without the fields managed by Grafana.
+// The uid is only kept when it is set in the configuration, it is exported in the uid attribute.
+func flattenGrafanaDashboardConfig(dashboard *grafanaDashboard, keepUID bool) (string, error) {
+	config := make(map[string]interface{}, len(dashboard.Dashboard))
+	for key, value := range dashboard.Dashboard {
+		config[key] = value
+	}
+
+	delete(config, "id")
+	delete(config, "version")
+	if !keepUID {
+		delete(config, "uid")
+	}
+
+	rawConfig, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+
+	return string(rawConfig), nil
+}
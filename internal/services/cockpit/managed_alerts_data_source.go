@@ -0,0 +1,114 @@
+package cockpit
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/cockpit/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+)
+
+func DataSourceManagedAlerts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceCockpitManagedAlertsRead,
+		Schema: map[string]*schema.Schema{
+			"product_family": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the alerts of this product family",
+			},
+			"product": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the alerts of this product",
+			},
+			"alerts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of managed alerts",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"product_family": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"product": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The PromQL expression of the alert",
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"project_id": account.ProjectIDSchema(),
+			"region":     regional.Schema(),
+		},
+	}
+}
+
+func DataSourceCockpitManagedAlertsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, region, err := cockpitAPIWithRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectID := d.Get("project_id").(string)
+	if projectID == "" {
+		projectID, err = getDefaultProjectID(ctx, m)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	res, err := api.ListManagedAlerts(&cockpit.RegionalAPIListManagedAlertsRequest{
+		Region:    region,
+		ProjectID: projectID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	productFamily := d.Get("product_family").(string)
+	product := d.Get("product").(string)
+
+	alerts := []interface{}(nil)
+	for _, alert := range res.Alerts {
+		if productFamily != "" && alert.ProductFamily != productFamily {
+			continue
+		}
+		if product != "" && alert.Product != product {
+			continue
+		}
+
+		alerts = append(alerts, map[string]interface{}{
+			"product_family": alert.ProductFamily,
+			"product":        alert.Product,
+			"name":           alert.Name,
+			"rule":           alert.Rule,
+			"description":    alert.Description,
+		})
+	}
+
+	d.SetId(datasource.NewRegionalID(projectID, region))
+	_ = d.Set("alerts", alerts)
+	_ = d.Set("project_id", projectID)
+	_ = d.Set("region", region)
+
+	return nil
+}
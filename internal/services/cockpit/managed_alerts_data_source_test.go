@@ -0,0 +1,48 @@
+package cockpit_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccDataSourceCockpitManagedAlerts_Basic(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_account_project" "project" {
+						name = "tf_tests_cockpit_managed_alerts"
+					}
+
+					resource "scaleway_cockpit_alert_manager" "main" {
+						project_id            = scaleway_account_project.project.id
+						enable_managed_alerts = true
+					}
+
+					data "scaleway_cockpit_managed_alerts" "all" {
+						project_id = scaleway_cockpit_alert_manager.main.project_id
+					}
+
+					data "scaleway_cockpit_managed_alerts" "instance" {
+						project_id = scaleway_cockpit_alert_manager.main.project_id
+						product    = "instance"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scaleway_cockpit_managed_alerts.all", "alerts.0.name"),
+					resource.TestCheckResourceAttrSet("data.scaleway_cockpit_managed_alerts.all", "alerts.0.rule"),
+					resource.TestCheckResourceAttr("data.scaleway_cockpit_managed_alerts.instance", "alerts.0.product", "instance"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,239 @@
+package cockpit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scaleway/scaleway-sdk-go/api/cockpit/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+)
+
+const tokenEphemeralResourcePrivateKey = "token"
+
+var (
+	_ ephemeral.EphemeralResource              = &TokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &TokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &TokenEphemeralResource{}
+)
+
+// TokenEphemeralResource creates a Cockpit token deleted when Terraform closes the ephemeral resource.
+type TokenEphemeralResource struct {
+	meta *meta.Meta
+}
+
+func NewTokenEphemeralResource() ephemeral.EphemeralResource { //nolint:ireturn
+	return &TokenEphemeralResource{}
+}
+
+type tokenEphemeralResourceModel struct {
+	Name      types.String `tfsdk:"name"`
+	Scopes    types.Set    `tfsdk:"scopes"`
+	Region    types.String `tfsdk:"region"`
+	ProjectID types.String `tfsdk:"project_id"`
+	ID        types.String `tfsdk:"id"`
+	SecretKey types.String `tfsdk:"secret_key"`
+}
+
+// tokenEphemeralResourcePrivate is stored in the private data of the ephemeral resource to delete the token on close.
+type tokenEphemeralResourcePrivate struct {
+	Region  scw.Region `json:"region"`
+	TokenID string     `json:"token_id"`
+}
+
+func (r *TokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cockpit_token"
+}
+
+func (r *TokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Create a Cockpit token for the duration of the Terraform run without persisting it in the state",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the token",
+			},
+			"scopes": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "The scopes of the token, one of " + strings.Join(tokenScopeNames(), ", "),
+			},
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The region of the token",
+			},
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the project of the token",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the token",
+			},
+			"secret_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The secret key of the token",
+			},
+		},
+	}
+}
+
+func (r *TokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	m, ok := req.ProviderData.(*meta.Meta)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *meta.Meta, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.meta = m
+}
+
+func (r *TokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data tokenEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rawScopes := []string(nil)
+	resp.Diagnostics.Append(data.Scopes.ElementsAs(ctx, &rawScopes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scopes := make([]cockpit.TokenScope, 0, len(rawScopes))
+	for _, rawScope := range rawScopes {
+		scope, ok := scopeMapping[rawScope]
+		if !ok {
+			resp.Diagnostics.AddAttributeError(path.Root("scopes"), "Invalid scope", fmt.Sprintf("Unknown scope %q, expected one of %s", rawScope, strings.Join(tokenScopeNames(), ", ")))
+
+			return
+		}
+		scopes = append(scopes, scope)
+	}
+
+	client := r.meta.ScwClient()
+
+	region, exist := client.GetDefaultRegion()
+	if data.Region.ValueString() != "" {
+		parsedRegion, err := scw.ParseRegion(data.Region.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("region"), "Invalid region", err.Error())
+
+			return
+		}
+		region = parsedRegion
+	} else if !exist {
+		resp.Diagnostics.AddAttributeError(path.Root("region"), "Missing region", regional.ErrRegionNotFound.Error())
+
+		return
+	}
+
+	projectID := data.ProjectID.ValueString()
+	if projectID == "" {
+		projectID, exist = client.GetDefaultProjectID()
+		if !exist {
+			resp.Diagnostics.AddAttributeError(path.Root("project_id"), "Missing project_id", "project_id must be set in the ephemeral resource or in the provider configuration")
+
+			return
+		}
+	}
+
+	name := data.Name.ValueString()
+	if name == "" {
+		name = "tf-ephemeral-token"
+	}
+
+	api := cockpit.NewRegionalAPI(client)
+
+	token, err := api.CreateToken(&cockpit.RegionalAPICreateTokenRequest{
+		Region:      region,
+		ProjectID:   projectID,
+		Name:        name,
+		TokenScopes: scopes,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create cockpit token", err.Error())
+
+		return
+	}
+
+	private, err := json.Marshal(&tokenEphemeralResourcePrivate{
+		Region:  region,
+		TokenID: token.ID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to store cockpit token ID", err.Error())
+
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, tokenEphemeralResourcePrivateKey, private)...)
+
+	data.ID = types.StringValue(regional.NewIDString(region, token.ID))
+	data.Name = types.StringValue(token.Name)
+	data.Region = types.StringValue(region.String())
+	data.ProjectID = types.StringValue(token.ProjectID)
+	data.SecretKey = types.StringPointerValue(token.SecretKey)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *TokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	rawPrivate, diags := req.Private.GetKey(ctx, tokenEphemeralResourcePrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || rawPrivate == nil {
+		return
+	}
+
+	private := &tokenEphemeralResourcePrivate{}
+
+	err := json.Unmarshal(rawPrivate, private)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read cockpit token ID", err.Error())
+
+		return
+	}
+
+	api := cockpit.NewRegionalAPI(r.meta.ScwClient())
+
+	err = api.DeleteToken(&cockpit.RegionalAPIDeleteTokenRequest{
+		Region:  private.Region,
+		TokenID: private.TokenID,
+	}, scw.WithContext(ctx))
+	if err != nil && !httperrors.Is404(err) {
+		resp.Diagnostics.AddError("Failed to delete cockpit token", err.Error())
+	}
+}
+
+// tokenScopeNames returns the sorted names of the scopes of a token.
+func tokenScopeNames() []string {
+	names := make([]string, 0, len(scopeMapping))
+	for name := range scopeMapping {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
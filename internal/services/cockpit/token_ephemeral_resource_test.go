@@ -0,0 +1,36 @@
+package cockpit_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccEphemeralResourceCockpitToken_Basic(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: tt.ProtoV6ProviderFactories,
+		CheckDestroy:             isTokenDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "scaleway_account_project" "project" {
+				  name = "tf_tests_cockpit_ephemeral_token"
+				}
+
+				ephemeral "scaleway_cockpit_token" "main" {
+				  project_id = scaleway_account_project.project.id
+				  name       = "tf-ephemeral-token"
+				  scopes     = ["query_metrics", "write_logs"]
+				}
+				`,
+			},
+		},
+	})
+}
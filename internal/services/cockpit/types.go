@@ -123,3 +123,16 @@ func flattenCockpitTokenScopes(scopes []cockpit.TokenScope) []interface{} {
 
 	return []interface{}{result}
 }
+
+func flattenUsage(usage *cockpit.Usage) []interface{} {
+	if usage == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"quantity": int(usage.QuantityOverInterval),
+			"unit":     usage.Unit.String(),
+		},
+	}
+}
@@ -0,0 +1,99 @@
+package cockpit
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/cockpit/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+func DataSourceUsage() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceCockpitUsageRead,
+		Schema: map[string]*schema.Schema{
+			"interval": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "720h",
+				ValidateDiagFunc: verify.IsDuration(),
+				Description:      "The interval over which the usage is computed, ending now",
+			},
+			"scaleway_metrics": usageSchema("Scaleway metrics"),
+			"scaleway_logs":    usageSchema("Scaleway logs"),
+			"external_metrics": usageSchema("external metrics"),
+			"external_logs":    usageSchema("external logs"),
+			"external_traces":  usageSchema("external traces"),
+			"project_id":       account.ProjectIDSchema(),
+			"region":           regional.Schema(),
+		},
+	}
+}
+
+func usageSchema(dataType string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The usage of the " + dataType + " data sources",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"quantity": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The quantity of " + dataType + " ingested over the interval",
+				},
+				"unit": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The unit of the quantity",
+				},
+			},
+		},
+	}
+}
+
+func DataSourceCockpitUsageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, region, err := cockpitAPIWithRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectID := d.Get("project_id").(string)
+	if projectID == "" {
+		projectID, err = getDefaultProjectID(ctx, m)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	interval, err := types.ExpandDuration(d.Get("interval"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := api.GetUsageOverview(&cockpit.RegionalAPIGetUsageOverviewRequest{
+		Region:    region,
+		ProjectID: projectID,
+		Interval:  scw.NewDurationFromTimeDuration(*interval),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(datasource.NewRegionalID(projectID, region))
+	_ = d.Set("scaleway_metrics", flattenUsage(res.ScalewayMetricsUsage))
+	_ = d.Set("scaleway_logs", flattenUsage(res.ScalewayLogsUsage))
+	_ = d.Set("external_metrics", flattenUsage(res.ExternalMetricsUsage))
+	_ = d.Set("external_logs", flattenUsage(res.ExternalLogsUsage))
+	_ = d.Set("external_traces", flattenUsage(res.ExternalTracesUsage))
+	_ = d.Set("project_id", projectID)
+	_ = d.Set("region", region)
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package cockpit_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccDataSourceCockpitUsage_Basic(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_account_project" "project" {
+						name = "tf_tests_cockpit_usage"
+					}
+
+					resource "scaleway_cockpit_source" "logs" {
+						project_id = scaleway_account_project.project.id
+						name       = "tf_tests_cockpit_usage"
+						type       = "logs"
+					}
+
+					data "scaleway_cockpit_usage" "main" {
+						project_id = scaleway_cockpit_source.logs.project_id
+						interval   = "24h"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_cockpit_usage.main", "external_logs.0.quantity", "0"),
+					resource.TestCheckResourceAttr("data.scaleway_cockpit_usage.main", "external_logs.0.unit", "bytes"),
+					resource.TestCheckResourceAttrSet("data.scaleway_cockpit_usage.main", "scaleway_metrics.0.unit"),
+				),
+			},
+		},
+	})
+}
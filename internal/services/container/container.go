@@ -17,6 +17,8 @@ import (
 
 const (
 	containerMaxConcurrencyLimit int = 80
+	// containerMaxTimeout is the maximum duration of a request in seconds, long-lived WebSocket and gRPC streams are closed after it
+	containerMaxTimeout int = 900
 )
 
 func ResourceContainer() *schema.Resource {
@@ -101,10 +103,11 @@ func ResourceContainer() *schema.Resource {
 				Description: "The amount of vCPU computing resources to allocate to each container. Defaults to 70.",
 			},
 			"timeout": {
-				Type:        schema.TypeInt,
-				Computed:    true,
-				Optional:    true,
-				Description: "The maximum amount of time in seconds during which your container can process a request before we stop it. Defaults to 300s.",
+				Type:         schema.TypeInt,
+				Computed:     true,
+				Optional:     true,
+				Description:  "The maximum amount of time in seconds during which your container can process a request before we stop it. Defaults to 300s.",
+				ValidateFunc: validation.IntBetween(1, containerMaxTimeout),
 			},
 			"privacy": {
 				Type:             schema.TypeString,
@@ -205,7 +208,7 @@ func ResourceContainerCreate(ctx context.Context, d *schema.ResourceData, m inte
 		return diag.Errorf("unexpected namespace error: %s", err)
 	}
 
-	req, err := setCreateContainerRequest(d, region)
+	req, err := setCreateContainerRequest(d, m, region)
 	if err != nil {
 		return diag.FromErr(err)
 	}
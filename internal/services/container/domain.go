@@ -12,6 +12,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
 
@@ -51,6 +52,40 @@ func ResourceDomain() *schema.Resource {
 				Computed:    true,
 				Description: "URL used to query the container",
 			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the domain and of its managed certificate",
+			},
+			"error_message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Error message if the domain is in error status",
+			},
+			"validation_record": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "DNS record required to validate the domain and issue its certificate",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the record",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the record",
+						},
+						"data": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Content of the record",
+						},
+					},
+				},
+			},
 			"region": regional.Schema(),
 		},
 		CustomizeDiff: cdf.LocalityCheck("container_id"),
@@ -82,12 +117,16 @@ func ResourceContainerDomainCreate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	_, err = waitForDomain(ctx, api, domain.ID, region, d.Timeout(schema.TimeoutCreate))
+	d.SetId(regional.NewIDString(region, domain.ID))
+
+	domain, err = waitForDomain(ctx, api, domain.ID, region, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(regional.NewIDString(region, domain.ID))
+	if domain.Status == container.DomainStatusError {
+		return diag.FromErr(domainError(domain))
+	}
 
 	return ResourceContainerDomainRead(ctx, d, m)
 }
@@ -110,8 +149,21 @@ func ResourceContainerDomainRead(ctx context.Context, d *schema.ResourceData, m
 	_ = d.Set("hostname", domain.Hostname)
 	_ = d.Set("container_id", domain.ContainerID)
 	_ = d.Set("url", domain.URL)
+	_ = d.Set("status", domain.Status.String())
+	_ = d.Set("error_message", types.FlattenStringPtr(domain.ErrorMessage))
 	_ = d.Set("region", region)
 
+	co, err := api.GetContainer(&container.GetContainerRequest{
+		Region:      region,
+		ContainerID: domain.ContainerID,
+	}, scw.WithContext(ctx))
+	if err != nil && !httperrors.Is404(err) {
+		return diag.FromErr(err)
+	}
+	if co != nil {
+		_ = d.Set("validation_record", flattenDomainValidationRecord(domain.Hostname, co.DomainName))
+	}
+
 	return nil
 }
 
@@ -58,6 +58,9 @@ func TestAccDomain_Basic(t *testing.T) {
 			`, testDNSZone),
 				Check: resource.ComposeTestCheckFunc(
 					isDomainPresent(tt, "scaleway_container_domain.app"),
+					resource.TestCheckResourceAttr("scaleway_container_domain.app", "status", "ready"),
+					resource.TestCheckResourceAttr("scaleway_container_domain.app", "validation_record.0.type", "CNAME"),
+					resource.TestCheckResourceAttrPair("scaleway_container_domain.app", "validation_record.0.name", "scaleway_container_domain.app", "hostname"),
 				),
 			},
 		},
@@ -3,6 +3,7 @@ package container
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -46,12 +47,12 @@ func NewAPIWithRegionAndID(m interface{}, id string) (*container.API, scw.Region
 	return api, region, id, nil
 }
 
-func setCreateContainerRequest(d *schema.ResourceData, region scw.Region) (*container.CreateContainerRequest, error) {
+func setCreateContainerRequest(d *schema.ResourceData, m interface{}, region scw.Region) (*container.CreateContainerRequest, error) {
 	// required
 	nameRaw := d.Get("name")
 	namespaceID := d.Get("namespace_id")
 
-	name := types.ExpandOrGenerateString(nameRaw.(string), "co")
+	name := meta.ExpandOrGenerateName(m, nameRaw.(string), "co")
 	privacyType := d.Get("privacy")
 	protocol := d.Get("protocol")
 	httpOption := d.Get("http_option")
@@ -162,3 +163,27 @@ func retryCreateContainerDomain(ctx context.Context, containerAPI *container.API
 		}
 	}
 }
+
+// flattenDomainValidationRecord returns the CNAME record the hostname must point to
+// for the domain to be validated and its certificate to be issued.
+func flattenDomainValidationRecord(hostname string, containerDomainName string) []map[string]interface{} {
+	if containerDomainName == "" {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"name": hostname,
+			"type": "CNAME",
+			"data": containerDomainName + ".",
+		},
+	}
+}
+
+func domainError(domain *container.Domain) error {
+	if domain.ErrorMessage != nil {
+		return fmt.Errorf("domain %s is in error status: %s", domain.Hostname, *domain.ErrorMessage)
+	}
+
+	return fmt.Errorf("domain %s is in error status", domain.Hostname)
+}
@@ -0,0 +1,253 @@
+package dedibox
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	dedibox "github.com/scaleway/scaleway-sdk-go/api/dedibox/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/cdf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+)
+
+func ResourceFailoverIPAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceDediboxFailoverIPAttachmentCreate,
+		ReadContext:   ResourceDediboxFailoverIPAttachmentRead,
+		UpdateContext: ResourceDediboxFailoverIPAttachmentUpdate,
+		DeleteContext: ResourceDediboxFailoverIPAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(defaultFailoverIPTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"failover_ip_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the failover IP",
+			},
+			"server_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the dedibox server the failover IP is attached to",
+			},
+			"reverse": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The reverse DNS of the failover IP",
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The address of the failover IP",
+			},
+			"netmask": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The netmask of the failover IP",
+			},
+			"gateway_ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The gateway of the failover IP",
+			},
+			"mac": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The virtual MAC address of the failover IP",
+			},
+			"ip_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the failover IP",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the failover IP",
+			},
+			"zone": zonal.Schema(),
+		},
+		CustomizeDiff: cdf.LocalityCheck("failover_ip_id", "server_id"),
+	}
+}
+
+func ResourceDediboxFailoverIPAttachmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, zone, err := newAPIWithZone(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ipID, err := expandID(d.Get("failover_ip_id"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	serverID, err := expandID(d.Get("server_id"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = waitForFailoverIP(ctx, api, zone, ipID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = api.AttachFailoverIPs(&dedibox.AttachFailoverIPsRequest{
+		Zone:     zone,
+		ServerID: serverID,
+		FipsIDs:  []uint64{ipID},
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(flattenID(zone, ipID))
+
+	_, err = waitForFailoverIP(ctx, api, zone, ipID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if reverse, ok := d.GetOk("reverse"); ok {
+		_, err = api.UpdateReverse(&dedibox.UpdateReverseRequest{
+			Zone:    zone,
+			IPID:    ipID,
+			Reverse: reverse.(string),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ResourceDediboxFailoverIPAttachmentRead(ctx, d, m)
+}
+
+func ResourceDediboxFailoverIPAttachmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, zone, ipID, err := NewAPIWithZoneAndID(m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ip, err := waitForFailoverIP(ctx, api, zone, ipID, d.Timeout(schema.TimeoutRead))
+	if err != nil {
+		if httperrors.Is404(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// The failover IP has been detached outside of terraform
+	if ip.ServerID == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("failover_ip_id", flattenID(zone, ip.ID))
+	_ = d.Set("server_id", flattenID(zone, *ip.ServerID))
+	_ = d.Set("reverse", ip.Reverse)
+	_ = d.Set("address", flattenNetIP(ip.Address))
+	_ = d.Set("netmask", flattenNetIP(ip.Netmask))
+	_ = d.Set("gateway_ip", flattenNetIP(ip.GatewayIP))
+	if ip.Mac != nil {
+		_ = d.Set("mac", *ip.Mac)
+	}
+	_ = d.Set("ip_version", ip.IPVersion.String())
+	_ = d.Set("status", ip.Status.String())
+	_ = d.Set("zone", zone)
+
+	return nil
+}
+
+func ResourceDediboxFailoverIPAttachmentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, zone, ipID, err := NewAPIWithZoneAndID(m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("server_id") {
+		serverID, err := expandID(d.Get("server_id"))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// A failover IP must be detached from its server before being attached to another one
+		err = api.DetachFailoverIPs(&dedibox.DetachFailoverIPsRequest{
+			Zone:    zone,
+			FipsIDs: []uint64{ipID},
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, err = waitForFailoverIP(ctx, api, zone, ipID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		err = api.AttachFailoverIPs(&dedibox.AttachFailoverIPsRequest{
+			Zone:     zone,
+			ServerID: serverID,
+			FipsIDs:  []uint64{ipID},
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, err = waitForFailoverIP(ctx, api, zone, ipID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("reverse") {
+		_, err = api.UpdateReverse(&dedibox.UpdateReverseRequest{
+			Zone:    zone,
+			IPID:    ipID,
+			Reverse: d.Get("reverse").(string),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ResourceDediboxFailoverIPAttachmentRead(ctx, d, m)
+}
+
+func ResourceDediboxFailoverIPAttachmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, zone, ipID, err := NewAPIWithZoneAndID(m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = waitForFailoverIP(ctx, api, zone, ipID, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		if httperrors.Is404(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	err = api.DetachFailoverIPs(&dedibox.DetachFailoverIPsRequest{
+		Zone:    zone,
+		FipsIDs: []uint64{ipID},
+	}, scw.WithContext(ctx))
+	if err != nil && !httperrors.Is404(err) {
+		return diag.FromErr(err)
+	}
+
+	_, err = waitForFailoverIP(ctx, api, zone, ipID, d.Timeout(schema.TimeoutDelete))
+	if err != nil && !httperrors.Is404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package dedibox_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	dediboxSDK "github.com/scaleway/scaleway-sdk-go/api/dedibox/v1"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/dedibox"
+)
+
+func TestAccFailoverIPAttachment_Basic(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+	hostname := testEnv(t, "SCW_DEDIBOX_SERVER_HOSTNAME")
+	failoverIPID := testEnv(t, "SCW_DEDIBOX_FAILOVER_IP_ID")
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      isFailoverIPDetached(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "scaleway_dedibox_server" "main" {
+						hostname = "%s"
+					}
+
+					resource "scaleway_dedibox_failover_ip_attachment" "main" {
+						failover_ip_id = "%s"
+						server_id      = data.scaleway_dedibox_server.main.server_id
+					}`, hostname, failoverIPID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("scaleway_dedibox_failover_ip_attachment.main", "server_id", "data.scaleway_dedibox_server.main", "server_id"),
+					resource.TestCheckResourceAttrSet("scaleway_dedibox_failover_ip_attachment.main", "address"),
+					resource.TestCheckResourceAttrSet("scaleway_dedibox_failover_ip_attachment.main", "mac"),
+					resource.TestCheckResourceAttr("scaleway_dedibox_failover_ip_attachment.main", "status", "ready"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "scaleway_dedibox_server" "main" {
+						hostname = "%s"
+					}
+
+					resource "scaleway_dedibox_failover_ip_attachment" "main" {
+						failover_ip_id = "%s"
+						server_id      = data.scaleway_dedibox_server.main.server_id
+						reverse        = "failover.scaleway-terraform.com"
+					}`, hostname, failoverIPID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scaleway_dedibox_failover_ip_attachment.main", "reverse", "failover.scaleway-terraform.com"),
+				),
+			},
+			{
+				ResourceName:      "scaleway_dedibox_failover_ip_attachment.main",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func isFailoverIPDetached(tt *acctest.TestTools) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for _, rs := range state.RootModule().Resources {
+			if rs.Type != "scaleway_dedibox_failover_ip_attachment" {
+				continue
+			}
+
+			api, zone, ipID, err := dedibox.NewAPIWithZoneAndID(tt.Meta, rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			ip, err := api.GetFailoverIP(&dediboxSDK.GetFailoverIPRequest{
+				Zone: zone,
+				IPID: ipID,
+			})
+			if err != nil {
+				return err
+			}
+
+			if ip.ServerID != nil {
+				return fmt.Errorf("failover IP (%s) is still attached", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,59 @@
+package dedibox
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	dedibox "github.com/scaleway/scaleway-sdk-go/api/dedibox/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+)
+
+const (
+	defaultFailoverIPTimeout = 5 * time.Minute
+)
+
+// newAPIWithZone returns a new dedibox API and the zone for a Create request
+func newAPIWithZone(d *schema.ResourceData, m interface{}) (*dedibox.API, scw.Zone, error) {
+	api := dedibox.NewAPI(meta.ExtractScwClient(m))
+
+	zone, err := meta.ExtractZone(d, m)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return api, zone, nil
+}
+
+// NewAPIWithZoneAndID returns a dedibox API with zone and numeric ID extracted from the state
+func NewAPIWithZoneAndID(m interface{}, id string) (*dedibox.API, scw.Zone, uint64, error) {
+	api := dedibox.NewAPI(meta.ExtractScwClient(m))
+
+	zone, rawID, err := zonal.ParseID(id)
+	if err != nil {
+		return nil, "", 0, err
+	}
+
+	ID, err := expandID(rawID)
+	if err != nil {
+		return nil, "", 0, err
+	}
+
+	return api, zone, ID, nil
+}
+
+// expandID returns the numeric ID of a dedibox object, with or without its zone
+func expandID(id interface{}) (uint64, error) {
+	rawID := locality.ExpandID(id)
+
+	ID, err := strconv.ParseUint(rawID, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid dedibox ID %q: %w", rawID, err)
+	}
+
+	return ID, nil
+}
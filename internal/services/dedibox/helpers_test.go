@@ -0,0 +1,19 @@
+package dedibox_test
+
+import (
+	"os"
+	"testing"
+)
+
+// Dedibox servers and failover IPs cannot be ordered through the API,
+// the tests run against resources already present in the recording account.
+func testEnv(t *testing.T, key string) string {
+	t.Helper()
+
+	value := os.Getenv(key)
+	if value == "" {
+		t.Skipf("%s must be set to record this test", key)
+	}
+
+	return value
+}
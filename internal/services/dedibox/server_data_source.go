@@ -0,0 +1,193 @@
+package dedibox
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	dedibox "github.com/scaleway/scaleway-sdk-go/api/dedibox/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+)
+
+func DataSourceServer() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceDediboxServerRead,
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the dedibox server",
+				ConflictsWith: []string{"hostname"},
+			},
+			"hostname": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The hostname of the dedibox server",
+				ConflictsWith: []string{"server_id"},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the server",
+			},
+			"offer": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the offer of the server",
+			},
+			"os": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the OS installed on the server",
+			},
+			"os_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the OS installed on the server",
+			},
+			"datacenter": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The datacenter of the server",
+			},
+			"rack": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The rack of the server",
+			},
+			"tags": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The tags of the server",
+			},
+			"ips": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IPs of the network interfaces of the server",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the IP",
+						},
+						"address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The address of the IP",
+						},
+						"reverse": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The reverse DNS of the IP",
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The version of the IP",
+						},
+						"semantic": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The usage of the IP, proxad for the main IP of the server",
+						},
+						"mac": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The MAC address of the network interface of the IP",
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the creation of the server",
+			},
+			"expired_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the expiration of the server",
+			},
+			"zone":            zonal.Schema(),
+			"organization_id": account.OrganizationIDSchema(),
+			"project_id":      account.ProjectIDSchema(),
+		},
+	}
+}
+
+func DataSourceDediboxServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, zone, err := newAPIWithZone(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var serverID uint64
+	if rawID, ok := d.GetOk("server_id"); ok {
+		serverID, err = expandID(rawID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		hostname := d.Get("hostname").(string)
+		res, err := api.ListServers(&dedibox.ListServersRequest{
+			Zone:      zone,
+			Search:    types.ExpandStringPtr(hostname),
+			ProjectID: types.ExpandStringPtr(d.Get("project_id")),
+		}, scw.WithContext(ctx), scw.WithAllPages())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		foundServer, err := datasource.FindExact(
+			res.Servers,
+			func(s *dedibox.ServerSummary) bool { return s.Hostname == hostname },
+			hostname,
+		)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		serverID = foundServer.ID
+	}
+
+	server, err := api.GetServer(&dedibox.GetServerRequest{
+		Zone:     zone,
+		ServerID: serverID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(flattenID(zone, server.ID))
+	_ = d.Set("server_id", flattenID(zone, server.ID))
+	_ = d.Set("hostname", server.Hostname)
+	_ = d.Set("status", server.Status.String())
+	if server.Offer != nil {
+		_ = d.Set("offer", server.Offer.Name)
+	}
+	if server.Os != nil {
+		_ = d.Set("os", server.Os.Name)
+		_ = d.Set("os_version", server.Os.Version)
+	}
+	if server.Location != nil {
+		_ = d.Set("datacenter", server.Location.DatacenterName)
+		_ = d.Set("rack", server.Location.Rack)
+	}
+	_ = d.Set("tags", server.Tags)
+	_ = d.Set("ips", flattenServerIPs(zone, server.Interfaces))
+	_ = d.Set("created_at", types.FlattenTime(server.CreatedAt))
+	_ = d.Set("expired_at", types.FlattenTime(server.ExpiredAt))
+	_ = d.Set("zone", zone)
+	_ = d.Set("organization_id", server.OrganizationID)
+	_ = d.Set("project_id", server.ProjectID)
+
+	return nil
+}
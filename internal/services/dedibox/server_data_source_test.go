@@ -0,0 +1,41 @@
+package dedibox_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccDataSourceServer_Basic(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+	hostname := testEnv(t, "SCW_DEDIBOX_SERVER_HOSTNAME")
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "scaleway_dedibox_server" "by_hostname" {
+						hostname = "%s"
+					}
+
+					data "scaleway_dedibox_server" "by_id" {
+						server_id = data.scaleway_dedibox_server.by_hostname.server_id
+					}`, hostname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_dedibox_server.by_hostname", "hostname", hostname),
+					resource.TestCheckResourceAttrSet("data.scaleway_dedibox_server.by_hostname", "status"),
+					resource.TestCheckResourceAttrSet("data.scaleway_dedibox_server.by_hostname", "offer"),
+					resource.TestCheckResourceAttrSet("data.scaleway_dedibox_server.by_hostname", "ips.0.address"),
+					resource.TestCheckResourceAttrPair("data.scaleway_dedibox_server.by_id", "hostname", "data.scaleway_dedibox_server.by_hostname", "hostname"),
+					resource.TestCheckResourceAttrPair("data.scaleway_dedibox_server.by_id", "ips.0.id", "data.scaleway_dedibox_server.by_hostname", "ips.0.id"),
+				),
+			},
+		},
+	})
+}
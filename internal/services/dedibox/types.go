@@ -0,0 +1,41 @@
+package dedibox
+
+import (
+	"net"
+	"strconv"
+
+	dedibox "github.com/scaleway/scaleway-sdk-go/api/dedibox/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+)
+
+func flattenServerIPs(zone scw.Zone, interfaces []*dedibox.NetworkInterface) []interface{} {
+	ips := []interface{}(nil)
+	for _, iface := range interfaces {
+		for _, ip := range iface.IPs {
+			ips = append(ips, map[string]interface{}{
+				"id":       flattenID(zone, ip.IPID),
+				"address":  flattenNetIP(ip.Address),
+				"reverse":  ip.Reverse,
+				"version":  ip.Version.String(),
+				"semantic": ip.Semantic.String(),
+				"mac":      iface.Mac,
+			})
+		}
+	}
+
+	return ips
+}
+
+func flattenID(zone scw.Zone, id uint64) string {
+	return zonal.NewIDString(zone, strconv.FormatUint(id, 10))
+}
+
+func flattenNetIP(ip net.IP) string {
+	if address := ip.String(); address != types.NetIPNil {
+		return address
+	}
+
+	return ""
+}
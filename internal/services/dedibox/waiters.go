@@ -0,0 +1,36 @@
+package dedibox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	dedibox "github.com/scaleway/scaleway-sdk-go/api/dedibox/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+// waitForFailoverIP waits for the failover IP to leave the busy status, the SDK has no waiter for failover IPs
+func waitForFailoverIP(ctx context.Context, api *dedibox.API, zone scw.Zone, ipID uint64, timeout time.Duration) (*dedibox.FailoverIP, error) {
+	var ip *dedibox.FailoverIP
+
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		var err error
+
+		ip, err = api.GetFailoverIP(&dedibox.GetFailoverIPRequest{
+			Zone: zone,
+			IPID: ipID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		if ip.Status == dedibox.FailoverIPStatusBusy {
+			return retry.RetryableError(fmt.Errorf("failover IP %d is %s", ipID, ip.Status))
+		}
+
+		return nil
+	})
+
+	return ip, err
+}
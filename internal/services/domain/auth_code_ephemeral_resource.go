@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	domain "github.com/scaleway/scaleway-sdk-go/api/domain/v2beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &AuthCodeEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &AuthCodeEphemeralResource{}
+)
+
+// AuthCodeEphemeralResource gives access to the auth code (EPP code) of a domain without storing it in the state.
+type AuthCodeEphemeralResource struct {
+	meta *meta.Meta
+}
+
+func NewAuthCodeEphemeralResource() ephemeral.EphemeralResource {
+	return &AuthCodeEphemeralResource{}
+}
+
+type authCodeEphemeralResourceModel struct {
+	Domain   types.String `tfsdk:"domain"`
+	AuthCode types.String `tfsdk:"auth_code"`
+}
+
+func (r *AuthCodeEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_auth_code"
+}
+
+func (r *AuthCodeEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Access the auth code of a registered domain without persisting it in the state",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				Required:    true,
+				Description: "The registered domain name",
+			},
+			"auth_code": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The auth code used to transfer the domain to another registrar",
+			},
+		},
+	}
+}
+
+func (r *AuthCodeEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	m, ok := req.ProviderData.(*meta.Meta)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *meta.Meta, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.meta = m
+}
+
+func (r *AuthCodeEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data authCodeEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	registrarAPI := domain.NewRegistrarAPI(r.meta.ScwClient())
+
+	res, err := registrarAPI.GetDomainAuthCode(&domain.RegistrarAPIGetDomainAuthCodeRequest{
+		Domain: data.Domain.ValueString(),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get domain auth code", err.Error())
+
+		return
+	}
+
+	data.AuthCode = types.StringValue(res.AuthCode)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
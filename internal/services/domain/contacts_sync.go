@@ -0,0 +1,320 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	domain "github.com/scaleway/scaleway-sdk-go/api/domain/v2beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+)
+
+var contactsSyncContactKeys = []string{"owner_contact_id", "administrative_contact_id", "technical_contact_id"}
+
+// contactsSyncMismatch is read as the contact ID when the domains do not use the same contact
+const contactsSyncMismatch = "mismatch"
+
+func ResourceContactsSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceContactsSyncCreate,
+		ReadContext:   resourceContactsSyncRead,
+		UpdateContext: resourceContactsSyncUpdate,
+		DeleteContext: resourceContactsSyncDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceContactsSyncImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(defaultDomainRecordTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"domains": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Required:    true,
+				MinItems:    1,
+				Description: "The registered domains to apply the contacts to",
+			},
+			"owner_contact_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: contactsSyncContactKeys,
+				Description:  "The ID of the owner contact, changing it starts a trade of the domains",
+			},
+			"administrative_contact_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: contactsSyncContactKeys,
+				Description:  "The ID of the administrative contact",
+			},
+			"technical_contact_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: contactsSyncContactKeys,
+				Description:  "The ID of the technical contact",
+			},
+			"domain_statuses": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The status of each domain",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The domain name",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the domain",
+						},
+						"pending_trade": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether a trade of the domain to the new owner contact is pending",
+						},
+						"owner_contact_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the current owner contact of the domain",
+						},
+						"administrative_contact_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the current administrative contact of the domain",
+						},
+						"technical_contact_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the current technical contact of the domain",
+						},
+					},
+				},
+			},
+			"project_id": account.ProjectIDSchema(),
+		},
+	}
+}
+
+func resourceContactsSyncCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	// The ID is set first so the domains already changed are kept in the state if some of them fail
+	d.SetId(id.UniqueId())
+
+	err := applyDomainsContacts(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceContactsSyncRead(ctx, d, m)
+}
+
+func resourceContactsSyncRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	domains, err := getContactsSyncDomains(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	statuses := make([]map[string]interface{}, 0, len(domains))
+	for _, res := range domains {
+		statuses = append(statuses, map[string]interface{}{
+			"domain":                    res.Domain,
+			"status":                    res.Status.String(),
+			"pending_trade":             res.PendingTrade,
+			"owner_contact_id":          contactID(res.OwnerContact),
+			"administrative_contact_id": contactID(res.AdministrativeContact),
+			"technical_contact_id":      contactID(res.TechnicalContact),
+		})
+	}
+
+	_ = d.Set("domain_statuses", statuses)
+
+	// Only the managed contacts are read, a pending trade counts as the configured owner contact
+	if ownerContactID := d.Get("owner_contact_id").(string); ownerContactID != "" {
+		_ = d.Set("owner_contact_id", flattenSyncedContactID(domains, func(res *domain.Domain) *domain.Contact {
+			if res.PendingTrade {
+				return &domain.Contact{ID: ownerContactID}
+			}
+			return res.OwnerContact
+		}))
+	}
+	if administrativeContactID := d.Get("administrative_contact_id").(string); administrativeContactID != "" {
+		_ = d.Set("administrative_contact_id", flattenSyncedContactID(domains, func(res *domain.Domain) *domain.Contact {
+			return res.AdministrativeContact
+		}))
+	}
+	if technicalContactID := d.Get("technical_contact_id").(string); technicalContactID != "" {
+		_ = d.Set("technical_contact_id", flattenSyncedContactID(domains, func(res *domain.Domain) *domain.Contact {
+			return res.TechnicalContact
+		}))
+	}
+
+	return nil
+}
+
+func resourceContactsSyncUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	if d.HasChanges("domains", "owner_contact_id", "administrative_contact_id", "technical_contact_id") {
+		err := applyDomainsContacts(ctx, d, m)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceContactsSyncRead(ctx, d, m)
+}
+
+// resourceContactsSyncImport imports the contacts of a comma-separated list of domains.
+// The contacts shared by all the domains are imported, the other ones are left unmanaged.
+func resourceContactsSyncImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	domainNames := strings.Split(d.Id(), ",")
+	for _, domainName := range domainNames {
+		if domainName == "" {
+			return nil, fmt.Errorf("invalid ID %q, expected a comma-separated list of domains", d.Id())
+		}
+	}
+
+	_ = d.Set("domains", domainNames)
+
+	domains, err := getContactsSyncDomains(ctx, d, m)
+	if err != nil {
+		return nil, err
+	}
+
+	contactGetters := map[string]func(res *domain.Domain) *domain.Contact{
+		"owner_contact_id":          func(res *domain.Domain) *domain.Contact { return res.OwnerContact },
+		"administrative_contact_id": func(res *domain.Domain) *domain.Contact { return res.AdministrativeContact },
+		"technical_contact_id":      func(res *domain.Domain) *domain.Contact { return res.TechnicalContact },
+	}
+	for key, getContact := range contactGetters {
+		if contactID := flattenSyncedContactID(domains, getContact); contactID != contactsSyncMismatch {
+			_ = d.Set(key, contactID)
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceContactsSyncDelete only removes the resource from the state, the contacts of the domains are left unchanged.
+func resourceContactsSyncDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	d.SetId("")
+
+	return nil
+}
+
+// applyDomainsContacts sets the configured contacts on the domains which do not already use them.
+// A new owner contact requires a trade of the domain, the other contacts are updated directly.
+func applyDomainsContacts(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	registrarAPI := NewRegistrarDomainAPI(m)
+
+	ownerContactID := d.Get("owner_contact_id").(string)
+	administrativeContactID := d.Get("administrative_contact_id").(string)
+	technicalContactID := d.Get("technical_contact_id").(string)
+
+	var errs []error
+	for _, domainName := range types.ExpandStrings(d.Get("domains").(*schema.Set).List()) {
+		res, err := registrarAPI.GetDomain(&domain.RegistrarAPIGetDomainRequest{
+			Domain: domainName,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to get domain %s: %w", domainName, err))
+			continue
+		}
+
+		if ownerContactID != "" && ownerContactID != contactID(res.OwnerContact) && !res.PendingTrade {
+			_, err = registrarAPI.TradeDomain(&domain.RegistrarAPITradeDomainRequest{
+				Domain:            domainName,
+				ProjectID:         types.ExpandStringPtr(d.Get("project_id")),
+				NewOwnerContactID: &ownerContactID,
+			}, scw.WithContext(ctx))
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to trade domain %s: %w", domainName, err))
+				continue
+			}
+		}
+
+		updateRequest := &domain.RegistrarAPIUpdateDomainRequest{
+			Domain: domainName,
+		}
+		hasChanged := false
+
+		if administrativeContactID != "" && administrativeContactID != contactID(res.AdministrativeContact) {
+			updateRequest.AdministrativeContactID = &administrativeContactID
+			hasChanged = true
+		}
+
+		if technicalContactID != "" && technicalContactID != contactID(res.TechnicalContact) {
+			updateRequest.TechnicalContactID = &technicalContactID
+			hasChanged = true
+		}
+
+		if hasChanged {
+			_, err = registrarAPI.UpdateDomain(updateRequest, scw.WithContext(ctx))
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to update the contacts of domain %s: %w", domainName, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// getContactsSyncDomains returns the domains of the resource, sorted by name
+func getContactsSyncDomains(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*domain.Domain, error) {
+	registrarAPI := NewRegistrarDomainAPI(m)
+
+	domainNames := types.ExpandStrings(d.Get("domains").(*schema.Set).List())
+	sort.Strings(domainNames)
+
+	domains := make([]*domain.Domain, 0, len(domainNames))
+	for _, domainName := range domainNames {
+		res, err := registrarAPI.GetDomain(&domain.RegistrarAPIGetDomainRequest{
+			Domain: domainName,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		domains = append(domains, res)
+	}
+
+	return domains, nil
+}
+
+// flattenSyncedContactID returns the contact ID used by all the domains, or contactsSyncMismatch when the domains use different contacts
+func flattenSyncedContactID(domains []*domain.Domain, getContact func(res *domain.Domain) *domain.Contact) string {
+	syncedID := ""
+	for i, res := range domains {
+		domainContactID := contactID(getContact(res))
+		if i > 0 && domainContactID != syncedID {
+			return contactsSyncMismatch
+		}
+		syncedID = domainContactID
+	}
+
+	return syncedID
+}
+
+func contactID(contact *domain.Contact) string {
+	if contact == nil {
+		return ""
+	}
+
+	return contact.ID
+}
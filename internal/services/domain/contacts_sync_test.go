@@ -0,0 +1,59 @@
+package domain_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccContactsSync_Basic(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data scaleway_domain_registration main {
+						domain = "%[1]s"
+					}
+
+					resource scaleway_domain_contacts_sync main {
+						domains              = ["%[1]s"]
+						technical_contact_id = data.scaleway_domain_registration.main.technical_contact.0.id
+					}
+				`, acctest.TestDomain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scaleway_domain_contacts_sync.main", "domain_statuses.#", "1"),
+					resource.TestCheckResourceAttr("scaleway_domain_contacts_sync.main", "domain_statuses.0.domain", acctest.TestDomain),
+					resource.TestCheckResourceAttrSet("scaleway_domain_contacts_sync.main", "domain_statuses.0.status"),
+					resource.TestCheckResourceAttrPair("scaleway_domain_contacts_sync.main", "domain_statuses.0.technical_contact_id", "data.scaleway_domain_registration.main", "technical_contact.0.id"),
+				),
+			},
+			{
+				ResourceName:  "scaleway_domain_contacts_sync.main",
+				ImportState:   true,
+				ImportStateId: acctest.TestDomain,
+				// The imported resource is identified by its domains, not by the generated ID of the created one
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported resource, got %d", len(states))
+					}
+					attributes := states[0].Attributes
+					if attributes["domains.#"] != "1" || attributes["technical_contact_id"] == "" {
+						return fmt.Errorf("unexpected imported attributes: %v", attributes)
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
@@ -42,3 +42,39 @@ func FindDefaultReverse(address string) string {
 	}
 	return strings.Join(parts, "-") + ".instances.scw.cloud"
 }
+
+// NewRegistrarDomainAPI returns a new registrar API.
+func NewRegistrarDomainAPI(m interface{}) *domain.RegistrarAPI {
+	return domain.NewRegistrarAPI(meta.ExtractScwClient(m))
+}
+
+// isWhoisPrivate returns whether none of the contacts of a domain opted in to be published in the WHOIS.
+func isWhoisPrivate(registration *domain.Domain) bool {
+	for _, contact := range []*domain.Contact{registration.OwnerContact, registration.AdministrativeContact, registration.TechnicalContact} {
+		if contact != nil && contact.WhoisOptIn {
+			return false
+		}
+	}
+
+	return true
+}
+
+func flattenDomainContact(contact *domain.Contact) []map[string]interface{} {
+	if contact == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"id":           contact.ID,
+			"legal_form":   contact.LegalForm.String(),
+			"firstname":    contact.Firstname,
+			"lastname":     contact.Lastname,
+			"company_name": contact.CompanyName,
+			"email":        contact.Email,
+			"email_status": contact.EmailStatus.String(),
+			"status":       contact.Status.String(),
+			"whois_opt_in": contact.WhoisOptIn,
+		},
+	}
+}
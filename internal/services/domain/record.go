@@ -246,9 +246,29 @@ func ResourceRecord() *schema.Resource {
 			},
 			"project_id": account.ProjectIDSchema(),
 		},
+		CustomizeDiff: customizeDiffRecord,
 	}
 }
 
+// customizeDiffRecord validates the name and data of the record at plan time, when they are known
+func customizeDiffRecord(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	recordType := domain.RecordType(diff.Get("type").(string))
+
+	if diff.NewValueKnown("name") {
+		if err := ValidateRecordName(recordType, diff.Get("name").(string)); err != nil {
+			return err
+		}
+	}
+
+	if diff.NewValueKnown("data") {
+		if err := ValidateRecordData(recordType, diff.Get("data").(string)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func resourceRecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	domainAPI := NewDomainAPI(m)
 
@@ -0,0 +1,174 @@
+package domain
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+
+	domain "github.com/scaleway/scaleway-sdk-go/api/domain/v2beta1"
+)
+
+// caaTags are the property tags defined by RFC 8659 and its extensions
+var caaTags = []string{"issue", "issuewild", "iodef", "issuemail", "issuevmc"}
+
+// ValidateRecordName checks that the record can be created with this name.
+// A CNAME cannot coexist with the SOA and NS records of the apex, ALIAS records are used instead.
+func ValidateRecordName(recordType domain.RecordType, name string) error {
+	if recordType == domain.RecordTypeCNAME && (name == "" || name == "@") {
+		return errors.New("a CNAME record cannot be created at the apex of the zone, use an ALIAS record instead")
+	}
+
+	return nil
+}
+
+// ValidateRecordData checks the format of the data of the record types the API only validates when applying the changes
+func ValidateRecordData(recordType domain.RecordType, data string) error {
+	switch recordType {
+	case domain.RecordTypeCAA:
+		return validateCAAData(data)
+	case domain.RecordTypeSSHFP:
+		return validateSSHFPData(data)
+	case domain.RecordTypeNAPTR:
+		return validateNAPTRData(data)
+	case domain.RecordTypeALIAS:
+		if net.ParseIP(data) != nil {
+			return fmt.Errorf("invalid ALIAS record data %q: expected a hostname, use A or AAAA records for IP addresses", data)
+		}
+	}
+
+	return nil
+}
+
+// validateCAAData validates data of the form `<flags> <tag> "<value>"`, e.g. `0 issue "letsencrypt.org"`
+func validateCAAData(data string) error {
+	fields, err := splitRecordData(data)
+	if err != nil {
+		return fmt.Errorf("invalid CAA record data %q: %w", data, err)
+	}
+	if len(fields) != 3 {
+		return fmt.Errorf("invalid CAA record data %q: expected `<flags> <tag> \"<value>\"`", data)
+	}
+
+	if err := validateUint(fields[0], 255); err != nil {
+		return fmt.Errorf("invalid CAA record flags %q: %w", fields[0], err)
+	}
+
+	tag := strings.ToLower(fields[1])
+	if !slices.Contains(caaTags, tag) {
+		return fmt.Errorf("invalid CAA record tag %q: expected one of %s", fields[1], strings.Join(caaTags, ", "))
+	}
+
+	if tag == "iodef" && !strings.HasPrefix(fields[2], "mailto:") && !strings.HasPrefix(fields[2], "http://") && !strings.HasPrefix(fields[2], "https://") {
+		return fmt.Errorf("invalid CAA iodef value %q: expected a mailto:, http:// or https:// URL", fields[2])
+	}
+
+	return nil
+}
+
+// validateSSHFPData validates data of the form `<algorithm> <fingerprint type> <fingerprint>`
+func validateSSHFPData(data string) error {
+	fields := strings.Fields(data)
+	if len(fields) != 3 {
+		return fmt.Errorf("invalid SSHFP record data %q: expected `<algorithm> <fingerprint type> <fingerprint>`", data)
+	}
+
+	if err := validateUint(fields[0], 255); err != nil {
+		return fmt.Errorf("invalid SSHFP record algorithm %q: %w", fields[0], err)
+	}
+
+	var fingerprintLength int
+	switch fields[1] {
+	case "1": // SHA-1
+		fingerprintLength = 40
+	case "2": // SHA-256
+		fingerprintLength = 64
+	default:
+		return fmt.Errorf("invalid SSHFP record fingerprint type %q: expected 1 (SHA-1) or 2 (SHA-256)", fields[1])
+	}
+
+	if _, err := hex.DecodeString(fields[2]); err != nil || len(fields[2]) != fingerprintLength {
+		return fmt.Errorf("invalid SSHFP record fingerprint %q: expected %d hexadecimal characters", fields[2], fingerprintLength)
+	}
+
+	return nil
+}
+
+// validateNAPTRData validates data of the form `<order> <preference> "<flags>" "<service>" "<regexp>" <replacement>`
+func validateNAPTRData(data string) error {
+	fields, err := splitRecordData(data)
+	if err != nil {
+		return fmt.Errorf("invalid NAPTR record data %q: %w", data, err)
+	}
+	if len(fields) != 6 {
+		return fmt.Errorf("invalid NAPTR record data %q: expected `<order> <preference> \"<flags>\" \"<service>\" \"<regexp>\" <replacement>`", data)
+	}
+
+	if err := validateUint(fields[0], 65535); err != nil {
+		return fmt.Errorf("invalid NAPTR record order %q: %w", fields[0], err)
+	}
+	if err := validateUint(fields[1], 65535); err != nil {
+		return fmt.Errorf("invalid NAPTR record preference %q: %w", fields[1], err)
+	}
+
+	for _, flag := range fields[2] {
+		if !strings.ContainsRune("SsAaUuPp", flag) {
+			return fmt.Errorf("invalid NAPTR record flags %q: expected S, A, U or P", fields[2])
+		}
+	}
+
+	if fields[4] != "" && fields[5] != "." {
+		return fmt.Errorf("invalid NAPTR record data %q: the regexp and the replacement are mutually exclusive, the replacement must be \".\" when a regexp is set", data)
+	}
+
+	return nil
+}
+
+func validateUint(value string, maxValue uint64) error {
+	v, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return errors.New("expected an unsigned integer")
+	}
+	if v > maxValue {
+		return fmt.Errorf("expected a value lower than or equal to %d", maxValue)
+	}
+
+	return nil
+}
+
+// splitRecordData splits record data on spaces, keeping the quoted strings together without their quotes
+func splitRecordData(data string) ([]string, error) {
+	var fields []string
+	var current strings.Builder
+	inQuotes := false
+	inField := false
+
+	for _, r := range data {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+			inField = true
+		case (r == ' ' || r == '\t') && !inQuotes:
+			if inField {
+				fields = append(fields, current.String())
+				current.Reset()
+				inField = false
+			}
+		default:
+			current.WriteRune(r)
+			inField = true
+		}
+	}
+
+	if inQuotes {
+		return nil, errors.New("unterminated quoted string")
+	}
+	if inField {
+		fields = append(fields, current.String())
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,55 @@
+package domain_test
+
+import (
+	"testing"
+
+	domainSDK "github.com/scaleway/scaleway-sdk-go/api/domain/v2beta1"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateRecordData(t *testing.T) {
+	tests := []struct {
+		recordType domainSDK.RecordType
+		data       string
+		valid      bool
+	}{
+		{domainSDK.RecordTypeCAA, `0 issue "letsencrypt.org"`, true},
+		{domainSDK.RecordTypeCAA, `128 issuewild ";"`, true},
+		{domainSDK.RecordTypeCAA, `0 iodef "mailto:security@example.com"`, true},
+		{domainSDK.RecordTypeCAA, `0 iodef "security@example.com"`, false},
+		{domainSDK.RecordTypeCAA, `0 issues "letsencrypt.org"`, false},
+		{domainSDK.RecordTypeCAA, `256 issue "letsencrypt.org"`, false},
+		{domainSDK.RecordTypeCAA, `0 issue "letsencrypt.org`, false},
+		{domainSDK.RecordTypeCAA, `issue "letsencrypt.org"`, false},
+		{domainSDK.RecordTypeSSHFP, "4 2 123456789abcdef67890123456789abcdef67890123456789abcdef123456789", true},
+		{domainSDK.RecordTypeSSHFP, "1 1 123456789abcdef67890123456789abcdef67890", true},
+		{domainSDK.RecordTypeSSHFP, "1 2 123456789abcdef67890123456789abcdef67890", false},
+		{domainSDK.RecordTypeSSHFP, "1 3 123456789abcdef67890123456789abcdef67890", false},
+		{domainSDK.RecordTypeSSHFP, "1 1 z23456789abcdef67890123456789abcdef67890", false},
+		{domainSDK.RecordTypeNAPTR, `100 10 "U" "E2U+sip" "!^.*$!sip:info@example.com!" .`, true},
+		{domainSDK.RecordTypeNAPTR, `100 10 "S" "SIP+D2U" "" _sip._udp.example.com.`, true},
+		{domainSDK.RecordTypeNAPTR, `100 10 "X" "SIP+D2U" "" _sip._udp.example.com.`, false},
+		{domainSDK.RecordTypeNAPTR, `100 10 "U" "E2U+sip" "!^.*$!sip:info@example.com!" example.com.`, false},
+		{domainSDK.RecordTypeNAPTR, `100 "U" "E2U+sip" "!^.*$!sip:info@example.com!" .`, false},
+		{domainSDK.RecordTypeALIAS, "web.example.com.", true},
+		{domainSDK.RecordTypeALIAS, "192.0.2.1", false},
+		{domainSDK.RecordTypeTXT, "anything goes", true},
+	}
+
+	for _, test := range tests {
+		err := domain.ValidateRecordData(test.recordType, test.data)
+		if test.valid {
+			assert.NoError(t, err, "%s %s", test.recordType, test.data)
+		} else {
+			assert.Error(t, err, "%s %s", test.recordType, test.data)
+		}
+	}
+}
+
+func TestValidateRecordName(t *testing.T) {
+	assert.Error(t, domain.ValidateRecordName(domainSDK.RecordTypeCNAME, ""))
+	assert.Error(t, domain.ValidateRecordName(domainSDK.RecordTypeCNAME, "@"))
+	assert.NoError(t, domain.ValidateRecordName(domainSDK.RecordTypeCNAME, "www"))
+	assert.NoError(t, domain.ValidateRecordName(domainSDK.RecordTypeALIAS, ""))
+}
@@ -0,0 +1,169 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	domain "github.com/scaleway/scaleway-sdk-go/api/domain/v2beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+)
+
+func DataSourceRegistration() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceRegistrationRead,
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The registered domain name",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the domain registration",
+			},
+			"icann_verified": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the email address of the owner contact has been verified as required by ICANN",
+			},
+			"whois_privacy": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the information of the contacts of the domain is hidden from the WHOIS",
+			},
+			"auto_renew_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the automatic renewal of the domain",
+			},
+			"dnssec_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of DNSSEC on the domain",
+			},
+			"registrar": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The registrar of the domain",
+			},
+			"is_external": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the domain is managed by an external registrar",
+			},
+			"pending_trade": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether a trade of the domain is pending",
+			},
+			"expired_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the expiration of the domain",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the last update of the domain",
+			},
+			"owner_contact":          registrationContactSchema("The owner contact of the domain"),
+			"administrative_contact": registrationContactSchema("The administrative contact of the domain"),
+			"technical_contact":      registrationContactSchema("The technical contact of the domain"),
+			"project_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The project ID of the domain",
+			},
+			"organization_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The organization ID of the domain",
+			},
+		},
+	}
+}
+
+func registrationContactSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"legal_form": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"firstname": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"lastname": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"company_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"email": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"email_status": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The ICANN verification status of the email address of the contact",
+				},
+				"status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"whois_opt_in": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func DataSourceRegistrationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	registrarAPI := NewRegistrarDomainAPI(m)
+
+	res, err := registrarAPI.GetDomain(&domain.RegistrarAPIGetDomainRequest{
+		Domain: d.Get("domain").(string),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(res.Domain)
+	_ = d.Set("domain", res.Domain)
+	_ = d.Set("status", res.Status.String())
+	_ = d.Set("icann_verified", res.OwnerContact != nil && res.OwnerContact.EmailStatus == domain.ContactEmailStatusValidated)
+	_ = d.Set("whois_privacy", isWhoisPrivate(res))
+	_ = d.Set("auto_renew_status", res.AutoRenewStatus.String())
+	if res.Dnssec != nil {
+		_ = d.Set("dnssec_status", res.Dnssec.Status.String())
+	}
+	_ = d.Set("registrar", res.Registrar)
+	_ = d.Set("is_external", res.IsExternal)
+	_ = d.Set("pending_trade", res.PendingTrade)
+	_ = d.Set("expired_at", types.FlattenTime(res.ExpiredAt))
+	_ = d.Set("updated_at", types.FlattenTime(res.UpdatedAt))
+	_ = d.Set("owner_contact", flattenDomainContact(res.OwnerContact))
+	_ = d.Set("administrative_contact", flattenDomainContact(res.AdministrativeContact))
+	_ = d.Set("technical_contact", flattenDomainContact(res.TechnicalContact))
+	_ = d.Set("project_id", res.ProjectID)
+	_ = d.Set("organization_id", res.OrganizationID)
+
+	return nil
+}
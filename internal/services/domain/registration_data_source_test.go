@@ -0,0 +1,37 @@
+package domain_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccDataSourceDomainRegistration_Basic(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data scaleway_domain_registration main {
+						domain = "%s"
+					}
+				`, acctest.TestDomain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_domain_registration.main", "domain", acctest.TestDomain),
+					resource.TestCheckResourceAttrSet("data.scaleway_domain_registration.main", "status"),
+					resource.TestCheckResourceAttrSet("data.scaleway_domain_registration.main", "icann_verified"),
+					resource.TestCheckResourceAttrSet("data.scaleway_domain_registration.main", "whois_privacy"),
+					resource.TestCheckResourceAttrSet("data.scaleway_domain_registration.main", "owner_contact.0.email_status"),
+				),
+			},
+		},
+	})
+}
@@ -4,8 +4,13 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	domaintestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/domain/testfuncs"
 )
 
+func init() {
+	domaintestfuncs.AddTestSweepers()
+}
+
 func TestMain(m *testing.M) {
 	resource.TestMain(m)
 }
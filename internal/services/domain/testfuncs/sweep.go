@@ -0,0 +1,101 @@
+package domaintestfuncs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	domainSDK "github.com/scaleway/scaleway-sdk-go/api/domain/v2beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/logging"
+)
+
+func AddTestSweepers() {
+	resource.AddTestSweepers("scaleway_domain_zone", &resource.Sweeper{
+		Name: "scaleway_domain_zone",
+		F:    testSweepZone,
+	})
+	resource.AddTestSweepers("scaleway_domain_registration", &resource.Sweeper{
+		Name: "scaleway_domain_registration",
+		F:    testSweepRegistration,
+	})
+}
+
+// testSweepZone deletes the zones created by the tests under the test domain, which are named test-*.<test domain>
+func testSweepZone(_ string) error {
+	if acctest.TestDomain == "" {
+		logging.L.Debugf("sweeper: skipping the domain zones, TF_TEST_DOMAIN is not set")
+		return nil
+	}
+
+	return acctest.Sweep(func(scwClient *scw.Client) error {
+		domainAPI := domainSDK.NewAPI(scwClient)
+		logging.L.Debugf("sweeper: destroying the domain zones of %s", acctest.TestDomain)
+
+		listDNSZones, err := domainAPI.ListDNSZones(&domainSDK.ListDNSZonesRequest{
+			Domain: acctest.TestDomain,
+		}, scw.WithAllPages())
+		if err != nil {
+			return fmt.Errorf("error listing domain zones in sweeper: %s", err)
+		}
+
+		for _, zone := range listDNSZones.DNSZones {
+			if zone.Domain != acctest.TestDomain || !isTestSubdomain(zone.Subdomain) || !acctest.IsOldEnoughToSweep(zone.UpdatedAt) {
+				continue
+			}
+
+			_, err := domainAPI.DeleteDNSZone(&domainSDK.DeleteDNSZoneRequest{
+				DNSZone:   fmt.Sprintf("%s.%s", zone.Subdomain, zone.Domain),
+				ProjectID: zone.ProjectID,
+			})
+			if err != nil {
+				logging.L.Debugf("sweeper: error (%s)", err)
+
+				return fmt.Errorf("error deleting domain zone in sweeper: %s", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// testSweepRegistration disables the auto-renewal of the domains registered by the tests.
+// A registration cannot be deleted, the domain is released when it expires.
+func testSweepRegistration(_ string) error {
+	return acctest.Sweep(func(scwClient *scw.Client) error {
+		registrarAPI := domainSDK.NewRegistrarAPI(scwClient)
+		logging.L.Debugf("sweeper: disabling the auto-renewal of the registered domains")
+
+		listDomains, err := registrarAPI.ListDomains(&domainSDK.RegistrarAPIListDomainsRequest{
+			IsExternal: scw.BoolPtr(false),
+		}, scw.WithAllPages())
+		if err != nil {
+			return fmt.Errorf("error listing registered domains in sweeper: %s", err)
+		}
+
+		for _, domain := range listDomains.Domains {
+			if domain.Domain == acctest.TestDomain || !acctest.IsTestResource(domain.Domain) {
+				continue
+			}
+			if domain.AutoRenewStatus != domainSDK.DomainFeatureStatusEnabled || !acctest.IsOldEnoughToSweep(domain.CreatedAt) {
+				continue
+			}
+
+			_, err := registrarAPI.DisableDomainAutoRenew(&domainSDK.RegistrarAPIDisableDomainAutoRenewRequest{
+				Domain: domain.Domain,
+			})
+			if err != nil {
+				logging.L.Debugf("sweeper: error (%s)", err)
+
+				return fmt.Errorf("error disabling auto-renewal of domain in sweeper: %s", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func isTestSubdomain(subdomain string) bool {
+	return subdomain != "" && (acctest.IsTestResource(subdomain) || strings.HasPrefix(subdomain, "test-"))
+}
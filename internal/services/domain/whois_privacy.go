@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	domain "github.com/scaleway/scaleway-sdk-go/api/domain/v2beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+)
+
+func ResourceWhoisPrivacy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWhoisPrivacyCreate,
+		ReadContext:   resourceWhoisPrivacyRead,
+		UpdateContext: resourceWhoisPrivacyUpdate,
+		DeleteContext: resourceWhoisPrivacyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The registered domain name",
+			},
+			"privacy": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Whether the information of the contacts of the domain is hidden from the WHOIS",
+			},
+			"contact_ids": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed:    true,
+				Description: "The IDs of the contacts of the domain the WHOIS opt-in is managed on",
+			},
+			"published_contact_ids": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed:    true,
+				Description: "The IDs of the contacts of the domain published in the WHOIS",
+			},
+		},
+	}
+}
+
+func resourceWhoisPrivacyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	domainName := d.Get("domain").(string)
+
+	err := applyWhoisPrivacy(ctx, NewRegistrarDomainAPI(m), domainName, d.Get("privacy").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(domainName)
+
+	return resourceWhoisPrivacyRead(ctx, d, m)
+}
+
+func resourceWhoisPrivacyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	registrarAPI := NewRegistrarDomainAPI(m)
+
+	res, err := registrarAPI.GetDomain(&domain.RegistrarAPIGetDomainRequest{
+		Domain: d.Id(),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if httperrors.Is404(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	contactIDs := []string{}
+	publishedContactIDs := []string{}
+	for _, contact := range domainContacts(res) {
+		contactIDs = append(contactIDs, contact.ID)
+		if contact.WhoisOptIn {
+			publishedContactIDs = append(publishedContactIDs, contact.ID)
+		}
+	}
+
+	_ = d.Set("domain", res.Domain)
+	_ = d.Set("privacy", isWhoisPrivate(res))
+	_ = d.Set("contact_ids", contactIDs)
+	_ = d.Set("published_contact_ids", publishedContactIDs)
+
+	return nil
+}
+
+func resourceWhoisPrivacyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChange("privacy") {
+		err := applyWhoisPrivacy(ctx, NewRegistrarDomainAPI(m), d.Id(), d.Get("privacy").(bool))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceWhoisPrivacyRead(ctx, d, m)
+}
+
+// resourceWhoisPrivacyDelete only removes the resource from the state, the WHOIS opt-in of the contacts is left unchanged.
+func resourceWhoisPrivacyDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	d.SetId("")
+
+	return nil
+}
+
+// applyWhoisPrivacy sets the WHOIS opt-in of the contacts of the domain, the registrar only stores it on the contacts.
+func applyWhoisPrivacy(ctx context.Context, registrarAPI *domain.RegistrarAPI, domainName string, privacy bool) error {
+	res, err := registrarAPI.GetDomain(&domain.RegistrarAPIGetDomainRequest{
+		Domain: domainName,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	whoisOptIn := !privacy
+	for _, contact := range domainContacts(res) {
+		if contact.WhoisOptIn == whoisOptIn {
+			continue
+		}
+
+		_, err = registrarAPI.UpdateContact(&domain.RegistrarAPIUpdateContactRequest{
+			ContactID:  contact.ID,
+			WhoisOptIn: &whoisOptIn,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("failed to update the WHOIS opt-in of contact %s: %w", contact.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// domainContacts returns the distinct contacts of a domain, a contact can have several roles
+func domainContacts(registration *domain.Domain) []*domain.Contact {
+	contacts := []*domain.Contact(nil)
+	seen := map[string]bool{}
+	for _, contact := range []*domain.Contact{registration.OwnerContact, registration.AdministrativeContact, registration.TechnicalContact} {
+		if contact == nil || seen[contact.ID] {
+			continue
+		}
+		seen[contact.ID] = true
+		contacts = append(contacts, contact)
+	}
+
+	return contacts
+}
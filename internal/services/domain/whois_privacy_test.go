@@ -0,0 +1,41 @@
+package domain_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccWhoisPrivacy_Basic(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource scaleway_domain_whois_privacy main {
+						domain  = "%s"
+						privacy = true
+					}
+				`, acctest.TestDomain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scaleway_domain_whois_privacy.main", "privacy", "true"),
+					resource.TestCheckResourceAttr("scaleway_domain_whois_privacy.main", "published_contact_ids.#", "0"),
+					resource.TestCheckResourceAttrSet("scaleway_domain_whois_privacy.main", "contact_ids.0"),
+				),
+			},
+			{
+				ResourceName:      "scaleway_domain_whois_privacy.main",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,401 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	domain "github.com/scaleway/scaleway-sdk-go/api/domain/v2beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+func ResourceZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceZoneRecordsCreate,
+		ReadContext:   resourceZoneRecordsRead,
+		UpdateContext: resourceZoneRecordsUpdate,
+		DeleteContext: resourceZoneRecordsDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(defaultDomainRecordTimeout),
+			Read:    schema.DefaultTimeout(defaultDomainRecordTimeout),
+			Update:  schema.DefaultTimeout(defaultDomainRecordTimeout),
+			Delete:  schema.DefaultTimeout(defaultDomainRecordTimeout),
+			Default: schema.DefaultTimeout(defaultDomainRecordTimeout),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"dns_zone": {
+				Type:        schema.TypeString,
+				Description: "The zone whose records are managed exclusively",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"default_ttl": {
+				Type:         schema.TypeInt,
+				Description:  "The ttl of the records without ttl",
+				Optional:     true,
+				Default:      3600,
+				ValidateFunc: validation.IntBetween(60, 2592000),
+			},
+			"normalize": {
+				Type:        schema.TypeBool,
+				Description: "Lowercase the names of the records and add the trailing dot to the hostnames of their data",
+				Optional:    true,
+				Default:     false,
+			},
+			"record": {
+				Type:        schema.TypeSet,
+				Description: "The records of the zone, the records of the zone that are not listed are deleted",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Description:  "The name of the record, empty for the apex of the zone",
+							Optional:     true,
+							Default:      "",
+							ValidateFunc: validation.StringNotInSlice([]string{"@"}, false),
+						},
+						"type": {
+							Type:             schema.TypeString,
+							Description:      "The type of the record",
+							Required:         true,
+							ValidateDiagFunc: verify.ValidateEnum[domain.RecordType](),
+						},
+						"data": {
+							Type:        schema.TypeString,
+							Description: "The data of the record",
+							Required:    true,
+						},
+						"ttl": {
+							Type:         schema.TypeInt,
+							Description:  "The ttl of the record, defaults to default_ttl",
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(60, 2592000),
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Description:  "The priority of the record",
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+		},
+		CustomizeDiff: customizeDiffZoneRecords,
+	}
+}
+
+// customizeDiffZoneRecords validates the records at plan time, the API rejects the whole set of changes on the first invalid record
+func customizeDiffZoneRecords(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("record") {
+		return nil
+	}
+
+	normalize := diff.Get("normalize").(bool)
+	defaultTTL := diff.Get("default_ttl").(int)
+
+	keys := map[string]bool{}
+	typesByName := map[string][]domain.RecordType{}
+	for _, raw := range diff.Get("record").(*schema.Set).List() {
+		record := expandZoneRecord(raw.(map[string]interface{}), defaultTTL, normalize)
+
+		if err := ValidateRecordName(record.Type, record.Name); err != nil {
+			return err
+		}
+		if err := ValidateRecordData(record.Type, record.Data); err != nil {
+			return err
+		}
+
+		key := zoneRecordKey(record)
+		if keys[key] {
+			return fmt.Errorf("duplicate %s record %q with data %q", record.Type, record.Name, record.Data)
+		}
+		keys[key] = true
+
+		typesByName[record.Name] = append(typesByName[record.Name], record.Type)
+	}
+
+	for name, recordTypes := range typesByName {
+		if len(recordTypes) > 1 && slices.Contains(recordTypes, domain.RecordTypeCNAME) {
+			return fmt.Errorf("a CNAME record cannot coexist with other records, %q has %d records", name, len(recordTypes))
+		}
+	}
+
+	return nil
+}
+
+func resourceZoneRecordsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId(d.Get("dns_zone").(string))
+
+	diags := resourceZoneRecordsApply(ctx, d, m, d.Timeout(schema.TimeoutCreate))
+	if diags.HasError() {
+		d.SetId("")
+		return diags
+	}
+
+	return resourceZoneRecordsRead(ctx, d, m)
+}
+
+func resourceZoneRecordsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	domainAPI := NewDomainAPI(m)
+
+	records, err := listZoneRecords(ctx, domainAPI, d.Id())
+	if err != nil {
+		if httperrors.Is404(err) || httperrors.Is403(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// Keep the records as written in the configuration when the API returns them in another format or with the default ttl
+	configuredRecords := configuredZoneRecords(d)
+
+	flattenedRecords := make([]interface{}, 0, len(records))
+	for _, record := range records {
+		if configuredRecord, ok := configuredRecords[zoneRecordKey(record)]; ok {
+			flattenedRecords = append(flattenedRecords, configuredRecord)
+			continue
+		}
+
+		flattenedRecords = append(flattenedRecords, map[string]interface{}{
+			"name":     record.Name,
+			"type":     record.Type.String(),
+			"data":     flattenDomainData(record.Data, record.Type),
+			"ttl":      int(record.TTL),
+			"priority": int(record.Priority),
+		})
+	}
+
+	_ = d.Set("dns_zone", d.Id())
+	_ = d.Set("record", flattenedRecords)
+
+	return nil
+}
+
+func resourceZoneRecordsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChanges("record", "default_ttl", "normalize") {
+		diags := resourceZoneRecordsApply(ctx, d, m, d.Timeout(schema.TimeoutUpdate))
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceZoneRecordsRead(ctx, d, m)
+}
+
+func resourceZoneRecordsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	domainAPI := NewDomainAPI(m)
+
+	records, err := listZoneRecords(ctx, domainAPI, d.Id())
+	if err != nil {
+		if httperrors.Is404(err) || httperrors.Is403(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	changes := []*domain.RecordChange(nil)
+	for _, record := range records {
+		changes = append(changes, &domain.RecordChange{
+			Delete: &domain.RecordChangeDelete{
+				ID: scw.StringPtr(record.ID),
+			},
+		})
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	_, err = domainAPI.UpdateDNSZoneRecords(&domain.UpdateDNSZoneRecordsRequest{
+		DNSZone:          d.Id(),
+		Changes:          changes,
+		ReturnAllRecords: scw.BoolPtr(false),
+	}, scw.WithContext(ctx))
+	if err != nil && !httperrors.Is404(err) && !httperrors.Is403(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// resourceZoneRecordsApply makes the records of the zone match the records of the configuration
+// in a single request: missing records are added and the records that are not configured are deleted.
+func resourceZoneRecordsApply(ctx context.Context, d *schema.ResourceData, m interface{}, timeout time.Duration) diag.Diagnostics {
+	domainAPI := NewDomainAPI(m)
+	dnsZone := d.Id()
+
+	existingRecords, err := listZoneRecords(ctx, domainAPI, dnsZone)
+	if err != nil && !httperrors.Is404(err) {
+		return diag.FromErr(err)
+	}
+
+	desiredRecords := expandZoneRecords(d)
+
+	existingKeys := make(map[string]bool, len(existingRecords))
+	changes := []*domain.RecordChange(nil)
+	for _, record := range existingRecords {
+		key := zoneRecordKey(record)
+		if _, desired := desiredRecords[key]; desired && !existingKeys[key] {
+			existingKeys[key] = true
+			continue
+		}
+
+		changes = append(changes, &domain.RecordChange{
+			Delete: &domain.RecordChangeDelete{
+				ID: scw.StringPtr(record.ID),
+			},
+		})
+	}
+
+	recordsToAdd := []*domain.Record(nil)
+	for key, record := range desiredRecords {
+		if !existingKeys[key] {
+			recordsToAdd = append(recordsToAdd, record)
+		}
+	}
+	if len(recordsToAdd) > 0 {
+		changes = append(changes, &domain.RecordChange{
+			Add: &domain.RecordChangeAdd{
+				Records: recordsToAdd,
+			},
+		})
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	_, err = domainAPI.UpdateDNSZoneRecords(&domain.UpdateDNSZoneRecordsRequest{
+		DNSZone:          dnsZone,
+		Changes:          changes,
+		ReturnAllRecords: scw.BoolPtr(false),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = waitForDNSZone(ctx, domainAPI, dnsZone, timeout)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// listZoneRecords returns the records of the zone, except the NS records of its apex which are managed by Scaleway
+func listZoneRecords(ctx context.Context, domainAPI *domain.API, dnsZone string) ([]*domain.Record, error) {
+	res, err := domainAPI.ListDNSZoneRecords(&domain.ListDNSZoneRecordsRequest{
+		DNSZone: dnsZone,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*domain.Record, 0, len(res.Records))
+	for _, record := range res.Records {
+		if record.Type == domain.RecordTypeNS && record.Name == "" {
+			continue
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func expandZoneRecords(d *schema.ResourceData) map[string]*domain.Record {
+	set := d.Get("record").(*schema.Set)
+	defaultTTL := d.Get("default_ttl").(int)
+	normalize := d.Get("normalize").(bool)
+
+	records := make(map[string]*domain.Record, set.Len())
+	for _, raw := range set.List() {
+		record := expandZoneRecord(raw.(map[string]interface{}), defaultTTL, normalize)
+		records[zoneRecordKey(record)] = record
+	}
+
+	return records
+}
+
+// configuredZoneRecords returns the records of the configuration, by the key of the record sent to the API
+func configuredZoneRecords(d *schema.ResourceData) map[string]map[string]interface{} {
+	set := d.Get("record").(*schema.Set)
+	defaultTTL := d.Get("default_ttl").(int)
+	normalize := d.Get("normalize").(bool)
+
+	records := make(map[string]map[string]interface{}, set.Len())
+	for _, raw := range set.List() {
+		rawRecord := raw.(map[string]interface{})
+		records[zoneRecordKey(expandZoneRecord(rawRecord, defaultTTL, normalize))] = rawRecord
+	}
+
+	return records
+}
+
+func expandZoneRecord(rawRecord map[string]interface{}, defaultTTL int, normalize bool) *domain.Record {
+	record := &domain.Record{
+		Name:     rawRecord["name"].(string),
+		Type:     domain.RecordType(rawRecord["type"].(string)),
+		Data:     rawRecord["data"].(string),
+		TTL:      uint32(rawRecord["ttl"].(int)),
+		Priority: uint32(rawRecord["priority"].(int)),
+	}
+
+	if record.TTL == 0 {
+		record.TTL = uint32(defaultTTL)
+	}
+
+	if normalize {
+		record.Name = strings.ToLower(record.Name)
+		record.Data = normalizeRecordData(record.Type, record.Data)
+	}
+
+	return record
+}
+
+// normalizeRecordData adds the trailing dot to the hostname of the data of the records pointing to another name
+func normalizeRecordData(recordType domain.RecordType, data string) string {
+	switch recordType {
+	case domain.RecordTypeCNAME, domain.RecordTypeALIAS, domain.RecordTypeDNAME, domain.RecordTypeMX, domain.RecordTypeNS, domain.RecordTypePTR:
+		return fqdn(data)
+	case domain.RecordTypeSRV: // {weight} {port} {target}
+		fields := strings.Fields(data)
+		if len(fields) > 0 {
+			fields[len(fields)-1] = fqdn(fields[len(fields)-1])
+		}
+
+		return strings.Join(fields, " ")
+	}
+
+	return data
+}
+
+func fqdn(hostname string) string {
+	if hostname == "" || strings.HasSuffix(hostname, ".") || net.ParseIP(hostname) != nil {
+		return hostname
+	}
+
+	return strings.ToLower(hostname) + "."
+}
+
+// zoneRecordKey identifies a record by its content, the name and data are normalized as the API may return them in another format
+func zoneRecordKey(record *domain.Record) string {
+	data := flattenDomainData(strings.ToLower(record.Data), record.Type).(string)
+
+	return fmt.Sprintf("%s/%s/%s/%d/%d", strings.ToLower(record.Name), record.Type, data, record.TTL, record.Priority)
+}
@@ -0,0 +1,163 @@
+package domain_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	domainSDK "github.com/scaleway/scaleway-sdk-go/api/domain/v2beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/domain"
+)
+
+func TestAccDomainZoneRecords_Basic(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	testDNSZone := "test-zone-records." + acctest.TestDomain
+	config := fmt.Sprintf(`
+		resource "scaleway_domain_zone_records" "main" {
+			dns_zone = "%s"
+
+			record {
+				name = "www"
+				type = "A"
+				data = "127.0.0.1"
+			}
+
+			record {
+				type     = "MX"
+				data     = "mx.example.com."
+				priority = 10
+				ttl      = 600
+			}
+		}
+	`, testDNSZone)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckDomainZoneRecordsCount(tt, testDNSZone, 0),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scaleway_domain_zone_records.main", "record.#", "2"),
+					testAccCheckDomainZoneRecordsCount(tt, testDNSZone, 2),
+					testAccAddDomainStrayRecord(tt, testDNSZone),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scaleway_domain_zone_records.main", "record.#", "2"),
+					testAccCheckDomainZoneRecordsCount(tt, testDNSZone, 2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDomainZoneRecords_DefaultTTLAndNormalize(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	testDNSZone := "test-zone-records-normalize." + acctest.TestDomain
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckDomainZoneRecordsCount(tt, testDNSZone, 0),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_domain_zone_records" "main" {
+						dns_zone    = "%s"
+						default_ttl = 300
+						normalize   = true
+
+						record {
+							name = "WWW"
+							type = "CNAME"
+							data = "Example.com"
+						}
+
+						record {
+							type = "ALIAS"
+							data = "example.com"
+							ttl  = 600
+						}
+					}
+				`, testDNSZone),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scaleway_domain_zone_records.main", "record.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("scaleway_domain_zone_records.main", "record.*", map[string]string{
+						"name": "WWW",
+						"data": "Example.com",
+						"ttl":  "0",
+					}),
+					testAccCheckDomainZoneRecordsCount(tt, testDNSZone, 2),
+				),
+			},
+		},
+	})
+}
+
+// testAccAddDomainStrayRecord adds a record outside of terraform, it must be deleted on the next apply
+func testAccAddDomainStrayRecord(tt *acctest.TestTools, dnsZone string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		_, err := domain.NewDomainAPI(tt.Meta).UpdateDNSZoneRecords(&domainSDK.UpdateDNSZoneRecordsRequest{
+			DNSZone: dnsZone,
+			Changes: []*domainSDK.RecordChange{
+				{
+					Add: &domainSDK.RecordChangeAdd{
+						Records: []*domainSDK.Record{
+							{
+								Name: "stray",
+								Type: domainSDK.RecordTypeTXT,
+								Data: "\"stray\"",
+								TTL:  3600,
+							},
+						},
+					},
+				},
+			},
+			ReturnAllRecords: scw.BoolPtr(false),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckDomainZoneRecordsCount(tt *acctest.TestTools, dnsZone string, expected int) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		res, err := domain.NewDomainAPI(tt.Meta).ListDNSZoneRecords(&domainSDK.ListDNSZoneRecordsRequest{
+			DNSZone: dnsZone,
+		}, scw.WithAllPages())
+		if expected == 0 && httperrors.Is404(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		count := 0
+		for _, record := range res.Records {
+			if record.Type != domainSDK.RecordTypeNS {
+				count++
+			}
+		}
+
+		if count != expected {
+			return fmt.Errorf("zone %s has %d records, expected %d", dnsZone, count, expected)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,16 @@
+package edgeservices_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	edgeservicestestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/edgeservices/testfuncs"
+)
+
+func init() {
+	edgeservicestestfuncs.AddTestSweepers()
+}
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
@@ -0,0 +1,47 @@
+package edgeservicestestfuncs
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	edgeservicesSDK "github.com/scaleway/scaleway-sdk-go/api/edge_services/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/logging"
+)
+
+func AddTestSweepers() {
+	resource.AddTestSweepers("scaleway_edge_services_pipeline", &resource.Sweeper{
+		Name: "scaleway_edge_services_pipeline",
+		F:    testSweepPipeline,
+	})
+}
+
+func testSweepPipeline(_ string) error {
+	return acctest.Sweep(func(scwClient *scw.Client) error {
+		edgeServicesAPI := edgeservicesSDK.NewAPI(scwClient)
+		logging.L.Debugf("sweeper: destroying the edge services pipelines")
+
+		listPipelines, err := edgeServicesAPI.ListPipelines(&edgeservicesSDK.ListPipelinesRequest{}, scw.WithAllPages())
+		if err != nil {
+			return fmt.Errorf("error listing pipelines in sweeper: %s", err)
+		}
+
+		for _, pipeline := range listPipelines.Pipelines {
+			if !acctest.IsTestResource(pipeline.Name) || !acctest.IsOldEnoughToSweep(pipeline.CreatedAt) {
+				continue
+			}
+
+			err := edgeServicesAPI.DeletePipeline(&edgeservicesSDK.DeletePipelineRequest{
+				PipelineID: pipeline.ID,
+			})
+			if err != nil {
+				logging.L.Debugf("sweeper: error (%s)", err)
+
+				return fmt.Errorf("error deleting pipeline in sweeper: %s", err)
+			}
+		}
+
+		return nil
+	})
+}
@@ -2,10 +2,15 @@ package flexibleip
 
 import (
 	"context"
+	"fmt"
+	"net"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	flexibleip "github.com/scaleway/scaleway-sdk-go/api/flexibleip/v1alpha1"
+	ipamSDK "github.com/scaleway/scaleway-sdk-go/api/ipam/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
@@ -52,3 +57,68 @@ func waitFlexibleIP(ctx context.Context, api *flexibleip.API, zone scw.Zone, id
 		RetryInterval: &retryInterval,
 	}, scw.WithContext(ctx))
 }
+
+// findFlexibleIPIPAMIP returns the IPAM IP of a flexible IP, it holds the reverses of every address of the flexible IP.
+// It returns nil if the flexible IP is not known by IPAM.
+func findFlexibleIPIPAMIP(ctx context.Context, m interface{}, zone scw.Zone, fipID string) (*ipamSDK.IP, error) {
+	region, err := zone.Region()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := ipamSDK.NewAPI(meta.ExtractScwClient(m)).ListIPs(&ipamSDK.ListIPsRequest{
+		Region:       region,
+		ResourceID:   &fipID,
+		ResourceType: ipamSDK.ResourceTypeFipIP,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.IPs) == 0 {
+		return nil, nil
+	}
+
+	return res.IPs[0], nil
+}
+
+func updateFlexibleIPReverses(ctx context.Context, m interface{}, zone scw.Zone, fipID string, reverses []*ipamSDK.Reverse) error {
+	ipamIP, err := findFlexibleIPIPAMIP(ctx, m, zone, fipID)
+	if err != nil {
+		return err
+	}
+
+	if ipamIP == nil {
+		return fmt.Errorf("no IPAM IP found for flexible IP %s", fipID)
+	}
+
+	_, err = ipamSDK.NewAPI(meta.ExtractScwClient(m)).UpdateIP(&ipamSDK.UpdateIPRequest{
+		Region:   ipamIP.Region,
+		IPID:     ipamIP.ID,
+		Reverses: reverses,
+	}, scw.WithContext(ctx))
+
+	return err
+}
+
+// validateReverses checks that the keys of the reverses map are IP addresses in their canonical form,
+// as they are returned by the API.
+func validateReverses() schema.SchemaValidateDiagFunc {
+	return func(value interface{}, path cty.Path) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		for address := range value.(map[string]interface{}) {
+			ip := net.ParseIP(address)
+			if ip == nil || ip.String() != address {
+				diags = append(diags, diag.Diagnostic{
+					Severity:      diag.Error,
+					AttributePath: path,
+					Summary:       "invalid reverses key, expected an IP address in its canonical form",
+					Detail:        "got " + address,
+				})
+			}
+		}
+
+		return diags
+	}
+}
@@ -0,0 +1,140 @@
+package flexibleip
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ipamSDK "github.com/scaleway/scaleway-sdk-go/api/ipam/v1"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/cdf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+// ResourceReverses manages the reverse DNS of every address of a flexible IP, such as an IPv6 block, in a single resource.
+func ResourceReverses() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceFlexibleIPReversesCreate,
+		ReadContext:   ResourceFlexibleIPReversesRead,
+		UpdateContext: ResourceFlexibleIPReversesUpdate,
+		DeleteContext: ResourceFlexibleIPReversesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(defaultFlexibleIPTimeout),
+			Read:    schema.DefaultTimeout(defaultFlexibleIPTimeout),
+			Update:  schema.DefaultTimeout(defaultFlexibleIPTimeout),
+			Delete:  schema.DefaultTimeout(defaultFlexibleIPTimeout),
+			Default: schema.DefaultTimeout(defaultFlexibleIPTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"flexible_ip_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: dsf.Locality,
+				Description:      "The ID of the flexible IP",
+			},
+			"reverses": {
+				Type:             schema.TypeMap,
+				Required:         true,
+				ValidateDiagFunc: validateReverses(),
+				Description:      "The reverse DNS of each address of the flexible IP, keyed by address",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"zone": zonal.Schema(),
+		},
+		CustomizeDiff: cdf.LocalityCheck("flexible_ip_id"),
+	}
+}
+
+func ResourceFlexibleIPReversesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	zone, err := meta.ExtractZone(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	fipID := zonal.ExpandID(d.Get("flexible_ip_id"))
+	if fipID.Zone != "" {
+		zone = fipID.Zone
+	}
+
+	err = updateFlexibleIPReverses(ctx, m, zone, fipID.ID, expandFlexibleIPReverses(d.Get("reverses")))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(zonal.NewIDString(zone, fipID.ID))
+
+	return ResourceFlexibleIPReversesRead(ctx, d, m)
+}
+
+func ResourceFlexibleIPReversesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	zone, fipID, err := zonal.ParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ipamIP, err := findFlexibleIPIPAMIP(ctx, m, zone, fipID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if ipamIP == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("flexible_ip_id", zonal.NewIDString(zone, fipID))
+	_ = d.Set("reverses", flattenFlexibleIPReverses(ipamIP.Reverses))
+	_ = d.Set("zone", zone)
+
+	return nil
+}
+
+func ResourceFlexibleIPReversesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	zone, fipID, err := zonal.ParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("reverses") {
+		err = updateFlexibleIPReverses(ctx, m, zone, fipID, expandFlexibleIPReverses(d.Get("reverses")))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ResourceFlexibleIPReversesRead(ctx, d, m)
+}
+
+func ResourceFlexibleIPReversesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	zone, fipID, err := zonal.ParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ipamIP, err := findFlexibleIPIPAMIP(ctx, m, zone, fipID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if ipamIP == nil {
+		return nil
+	}
+
+	err = updateFlexibleIPReverses(ctx, m, zone, fipID, []*ipamSDK.Reverse{})
+	if err != nil && !httperrors.Is404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package flexibleip_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccFlexibleIPReverses_IPv6(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckFlexibleIPDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+						resource "scaleway_flexible_ip" "main" {
+							is_ipv6 = true
+						}
+
+						resource "scaleway_flexible_ip_reverses" "main" {
+							flexible_ip_id = scaleway_flexible_ip.main.id
+							reverses = {
+								(cidrhost(scaleway_flexible_ip.main.ip_address, 1)) = "one.example.com"
+								(cidrhost(scaleway_flexible_ip.main.ip_address, 2)) = "two.example.com"
+							}
+						}
+					`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlexibleIPExists(tt, "scaleway_flexible_ip.main"),
+					resource.TestCheckResourceAttrPair("scaleway_flexible_ip_reverses.main", "id", "scaleway_flexible_ip.main", "id"),
+					resource.TestCheckResourceAttr("scaleway_flexible_ip_reverses.main", "reverses.%", "2"),
+				),
+			},
+			{
+				Config: `
+						resource "scaleway_flexible_ip" "main" {
+							is_ipv6 = true
+						}
+
+						resource "scaleway_flexible_ip_reverses" "main" {
+							flexible_ip_id = scaleway_flexible_ip.main.id
+							reverses = {
+								(cidrhost(scaleway_flexible_ip.main.ip_address, 1)) = "one.example.com"
+							}
+						}
+					`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scaleway_flexible_ip_reverses.main", "reverses.%", "1"),
+				),
+			},
+			{
+				ResourceName:      "scaleway_flexible_ip_reverses.main",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
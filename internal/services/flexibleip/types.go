@@ -1,7 +1,11 @@
 package flexibleip
 
 import (
+	"net"
+
 	flexibleip "github.com/scaleway/scaleway-sdk-go/api/flexibleip/v1alpha1"
+	ipamSDK "github.com/scaleway/scaleway-sdk-go/api/ipam/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
@@ -21,3 +25,27 @@ func flattenFlexibleIPMacAddress(mac *flexibleip.MACAddress) interface{} {
 		},
 	}
 }
+
+func expandFlexibleIPReverses(raw interface{}) []*ipamSDK.Reverse {
+	reverses := []*ipamSDK.Reverse{}
+	for address, hostname := range raw.(map[string]interface{}) {
+		reverses = append(reverses, &ipamSDK.Reverse{
+			Hostname: hostname.(string),
+			Address:  scw.IPPtr(net.ParseIP(address)),
+		})
+	}
+
+	return reverses
+}
+
+func flattenFlexibleIPReverses(reverses []*ipamSDK.Reverse) map[string]interface{} {
+	flattened := make(map[string]interface{}, len(reverses))
+	for _, reverse := range reverses {
+		if reverse.Address == nil {
+			continue
+		}
+		flattened[reverse.Address.String()] = reverse.Hostname
+	}
+
+	return flattened
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
 
@@ -50,6 +51,40 @@ func ResourceDomain() *schema.Resource {
 				Description: "URL to use to trigger the function",
 				Computed:    true,
 			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the domain and of its managed certificate",
+				Computed:    true,
+			},
+			"error_message": {
+				Type:        schema.TypeString,
+				Description: "The error message if the domain is in error status",
+				Computed:    true,
+			},
+			"validation_record": {
+				Type:        schema.TypeList,
+				Description: "The DNS record required to validate the domain and issue its certificate",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the record",
+							Computed:    true,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Description: "The type of the record",
+							Computed:    true,
+						},
+						"data": {
+							Type:        schema.TypeString,
+							Description: "The content of the record",
+							Computed:    true,
+						},
+					},
+				},
+			},
 			"region": regional.Schema(),
 		},
 		CustomizeDiff: cdf.LocalityCheck("function_id"),
@@ -83,11 +118,15 @@ func ResourceFunctionDomainCreate(ctx context.Context, d *schema.ResourceData, m
 
 	d.SetId(regional.NewIDString(region, domain.ID))
 
-	_, err = waitForDomain(ctx, api, region, domain.ID, d.Timeout(schema.TimeoutCreate))
+	domain, err = waitForDomain(ctx, api, region, domain.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if domain.Status == function.DomainStatusError {
+		return diag.FromErr(domainError(domain))
+	}
+
 	return ResourceFunctionDomainRead(ctx, d, m)
 }
 
@@ -109,8 +148,21 @@ func ResourceFunctionDomainRead(ctx context.Context, d *schema.ResourceData, m i
 	_ = d.Set("hostname", domain.Hostname)
 	_ = d.Set("function_id", regional.NewIDString(region, domain.FunctionID))
 	_ = d.Set("url", domain.URL)
+	_ = d.Set("status", domain.Status.String())
+	_ = d.Set("error_message", types.FlattenStringPtr(domain.ErrorMessage))
 	_ = d.Set("region", region)
 
+	f, err := api.GetFunction(&function.GetFunctionRequest{
+		Region:     region,
+		FunctionID: domain.FunctionID,
+	}, scw.WithContext(ctx))
+	if err != nil && !httperrors.Is404(err) {
+		return diag.FromErr(err)
+	}
+	if f != nil {
+		_ = d.Set("validation_record", flattenDomainValidationRecord(domain.Hostname, f.DomainName))
+	}
+
 	return nil
 }
 
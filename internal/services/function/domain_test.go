@@ -69,6 +69,9 @@ func TestAccFunctionDomain_Basic(t *testing.T) {
 				`, testDNSZone),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckFunctionDomainExists(tt, "scaleway_function_domain.main"),
+					resource.TestCheckResourceAttr("scaleway_function_domain.main", "status", "ready"),
+					resource.TestCheckResourceAttr("scaleway_function_domain.main", "validation_record.0.type", "CNAME"),
+					resource.TestCheckResourceAttrPair("scaleway_function_domain.main", "validation_record.0.name", "scaleway_function_domain.main", "hostname"),
 				),
 			},
 		},
@@ -15,6 +15,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -187,7 +188,7 @@ func ResourceFunctionCreate(ctx context.Context, d *schema.ResourceData, m inter
 		MaxScale:                   types.ExpandUint32Ptr(d.Get("max_scale")),
 		MemoryLimit:                types.ExpandUint32Ptr(d.Get("memory_limit")),
 		MinScale:                   types.ExpandUint32Ptr(d.Get("min_scale")),
-		Name:                       types.ExpandOrGenerateString(d.Get("name").(string), "func"),
+		Name:                       meta.ExpandOrGenerateName(m, d.Get("name").(string), "func"),
 		NamespaceID:                namespace,
 		Privacy:                    function.FunctionPrivacy(d.Get("privacy").(string)),
 		Region:                     region,
@@ -169,3 +169,27 @@ func retryCreateFunctionDomain(ctx context.Context, functionAPI *function.API, r
 		}
 	}
 }
+
+// flattenDomainValidationRecord returns the CNAME record the hostname must point to
+// for the domain to be validated and its certificate to be issued.
+func flattenDomainValidationRecord(hostname string, functionDomainName string) []map[string]interface{} {
+	if functionDomainName == "" {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"name": hostname,
+			"type": "CNAME",
+			"data": functionDomainName + ".",
+		},
+	}
+}
+
+func domainError(domain *function.Domain) error {
+	if domain.ErrorMessage != nil {
+		return fmt.Errorf("domain %s is in error status: %s", domain.Hostname, *domain.ErrorMessage)
+	}
+
+	return fmt.Errorf("domain %s is in error status", domain.Hostname)
+}
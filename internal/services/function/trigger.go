@@ -13,6 +13,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -144,7 +145,7 @@ func ResourceFunctionTriggerCreate(ctx context.Context, d *schema.ResourceData,
 
 	req := &function.CreateTriggerRequest{
 		Region:      region,
-		Name:        types.ExpandOrGenerateString(d.Get("name").(string), "trigger"),
+		Name:        meta.ExpandOrGenerateName(m, d.Get("name").(string), "trigger"),
 		FunctionID:  locality.ExpandID(d.Get("function_id")),
 		Description: types.ExpandStringPtr(d.Get("description")),
 	}
@@ -2,6 +2,7 @@ package iam
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -25,6 +26,12 @@ func ResourceAPIKey() *schema.Resource {
 		},
 		SchemaVersion: 0,
 		Schema: map[string]*schema.Schema{
+			// The ID is declared so a rotation can plan it as unknown, it is the access key of the current api key
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The access key of the current iam api key, it changes when the api key is rotated",
+			},
 			"description": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -43,10 +50,18 @@ func ResourceAPIKey() *schema.Resource {
 			"expires_at": {
 				Type:             schema.TypeString,
 				Optional:         true,
-				ForceNew:         true,
+				Computed:         true,
 				Description:      "The date and time of the expiration of the iam api key. Cannot be changed afterwards",
 				ValidateDiagFunc: verify.IsDate(),
 				DiffSuppressFunc: dsf.TimeRFC3339,
+				ConflictsWith:    []string{"expires_in"},
+			},
+			"expires_in": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "How long the iam api key is valid, it is rotated by the first apply after half of this duration",
+				ValidateDiagFunc: verify.IsDuration(),
+				ConflictsWith:    []string{"expires_at"},
 			},
 			"access_key": {
 				Type:        schema.TypeString,
@@ -85,16 +100,146 @@ func ResourceAPIKey() *schema.Resource {
 				Description: "The IPv4 Address of the device which created the API key",
 			},
 			"default_project_id": account.ProjectIDSchema(),
+			"rotate_when_changed": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Arbitrary map of values that, when changed, will create a new api key and keep the previous one during the rotation overlap",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"rotation_overlap": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "0s",
+				Description:      "How long the previous api key is kept after a rotation, it is deleted by the first apply after this duration",
+				ValidateDiagFunc: verify.IsDuration(),
+			},
+			"rotated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the last rotation of the iam api key",
+			},
+			"previous_access_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The access key of the api key replaced by the last rotation, while it is kept",
+			},
+			"previous_secret_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The secret key of the api key replaced by the last rotation, while it is kept",
+			},
 		},
+		CustomizeDiff: customizeDiffAPIKeyRotation,
 	}
 }
 
+// apiKeyRotationComputedKeys are the attributes set by a rotation
+var apiKeyRotationComputedKeys = []string{
+	"id",
+	"access_key",
+	"secret_key",
+	"previous_access_key",
+	"previous_secret_key",
+	"rotated_at",
+	"created_at",
+	"updated_at",
+	"creation_ip",
+}
+
+// customizeDiffAPIKeyRotation plans the rotation of the api key, when rotate_when_changed changes or the key must be extended,
+// and the deletion of the previous api key once the rotation overlap is over.
+func customizeDiffAPIKeyRotation(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	// A fixed expiration cannot be changed on an existing api key
+	if d.HasChange("expires_at") && d.Get("expires_in").(string) == "" {
+		return d.ForceNew("expires_at")
+	}
+
+	extensionDue, err := isAPIKeyExtensionDue(d.Get("expires_in"), d.Get("expires_at"))
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("rotate_when_changed") || extensionDue {
+		for _, key := range apiKeyRotationComputedKeys {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+		if d.Get("expires_in").(string) != "" {
+			return d.SetNewComputed("expires_at")
+		}
+
+		return nil
+	}
+
+	if d.Get("previous_access_key").(string) == "" {
+		return nil
+	}
+
+	rotatedAt := types.ExpandTimePtr(d.Get("rotated_at"))
+	overlap, err := types.ExpandDuration(d.Get("rotation_overlap"))
+	if err != nil {
+		return err
+	}
+
+	if rotatedAt != nil && overlap != nil && time.Now().Before(rotatedAt.Add(*overlap)) {
+		return nil
+	}
+
+	if err := d.SetNew("previous_access_key", ""); err != nil {
+		return err
+	}
+
+	return d.SetNew("previous_secret_key", "")
+}
+
+// isAPIKeyExtensionDue returns whether an api key valid for expires_in has used half of its validity and must be replaced by a new one
+func isAPIKeyExtensionDue(rawExpiresIn interface{}, rawExpiresAt interface{}) (bool, error) {
+	expiresIn, err := types.ExpandDuration(rawExpiresIn)
+	if err != nil || expiresIn == nil {
+		return false, err
+	}
+
+	expiresAt := types.ExpandTimePtr(rawExpiresAt)
+	if expiresAt == nil {
+		return false, nil
+	}
+
+	return time.Now().After(expiresAt.Add(-*expiresIn / 2)), nil
+}
+
+// expandAPIKeyExpiration returns the expiration of a new api key: the fixed expires_at, or expires_in from now
+func expandAPIKeyExpiration(d *schema.ResourceData) (*time.Time, error) {
+	expiresIn, err := types.ExpandDuration(d.Get("expires_in"))
+	if err != nil {
+		return nil, err
+	}
+	if expiresIn != nil {
+		return scw.TimePtr(time.Now().Add(*expiresIn)), nil
+	}
+
+	return types.ExpandTimePtr(d.Get("expires_at")), nil
+}
+
 func resourceIamAPIKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := NewAPI(m)
+
+	expiresAt, err := expandAPIKeyExpiration(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	res, err := api.CreateAPIKey(&iam.CreateAPIKeyRequest{
 		ApplicationID:    types.ExpandStringPtr(d.Get("application_id")),
 		UserID:           types.ExpandStringPtr(d.Get("user_id")),
-		ExpiresAt:        types.ExpandTimePtr(d.Get("expires_at")),
+		ExpiresAt:        expiresAt,
 		DefaultProjectID: types.ExpandStringPtr(d.Get("default_project_id")),
 		Description:      d.Get("description").(string),
 	}, scw.WithContext(ctx))
@@ -144,6 +289,24 @@ func resourceIamAPIKeyRead(ctx context.Context, d *schema.ResourceData, m interf
 func resourceIamAPIKeyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := NewAPI(m)
 
+	// The access key is unknown when a rotation was planned, because rotate_when_changed changed or the expiration must be extended
+	rotationPlanned := d.HasChange("rotate_when_changed") || d.Get("access_key").(string) == ""
+
+	var err error
+
+	switch {
+	case rotationPlanned:
+		err = rotateAPIKey(ctx, api, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	case d.HasChange("previous_access_key"):
+		err = deletePreviousAPIKey(ctx, api, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	req := &iam.UpdateAPIKeyRequest{
 		AccessKey: d.Id(),
 	}
@@ -173,7 +336,12 @@ func resourceIamAPIKeyUpdate(ctx context.Context, d *schema.ResourceData, m inte
 func resourceIamAPIKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := NewAPI(m)
 
-	err := api.DeleteAPIKey(&iam.DeleteAPIKeyRequest{
+	err := deletePreviousAPIKey(ctx, api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = api.DeleteAPIKey(&iam.DeleteAPIKeyRequest{
 		AccessKey: d.Id(),
 	}, scw.WithContext(ctx))
 	if err != nil && !httperrors.Is404(err) {
@@ -182,3 +350,60 @@ func resourceIamAPIKeyDelete(ctx context.Context, d *schema.ResourceData, m inte
 
 	return nil
 }
+
+// rotateAPIKey creates a new api key with the same attributes and keeps the current one as the previous key.
+// An api key kept from an earlier rotation is deleted. The ID becomes the access key of the new api key.
+func rotateAPIKey(ctx context.Context, api *iam.API, d *schema.ResourceData) error {
+	oldAccessKey := d.Id()
+	oldSecretKey, _ := d.GetChange("secret_key")
+
+	err := deletePreviousAPIKey(ctx, api, d)
+	if err != nil {
+		return err
+	}
+
+	expiresAt, err := expandAPIKeyExpiration(d)
+	if err != nil {
+		return err
+	}
+
+	res, err := api.CreateAPIKey(&iam.CreateAPIKeyRequest{
+		ApplicationID:    types.ExpandStringPtr(d.Get("application_id")),
+		UserID:           types.ExpandStringPtr(d.Get("user_id")),
+		ExpiresAt:        expiresAt,
+		DefaultProjectID: types.ExpandStringPtr(d.Get("default_project_id")),
+		Description:      d.Get("description").(string),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(res.AccessKey)
+	_ = d.Set("previous_access_key", oldAccessKey)
+	_ = d.Set("previous_secret_key", oldSecretKey)
+	_ = d.Set("access_key", res.AccessKey)
+	_ = d.Set("secret_key", res.SecretKey)
+	_ = d.Set("rotated_at", types.FlattenTime(scw.TimePtr(time.Now())))
+
+	return nil
+}
+
+// deletePreviousAPIKey deletes the api key kept by the last rotation, if any.
+func deletePreviousAPIKey(ctx context.Context, api *iam.API, d *schema.ResourceData) error {
+	previousAccessKey, _ := d.GetChange("previous_access_key")
+	if previousAccessKey.(string) == "" {
+		return nil
+	}
+
+	err := api.DeleteAPIKey(&iam.DeleteAPIKeyRequest{
+		AccessKey: previousAccessKey.(string),
+	}, scw.WithContext(ctx))
+	if err != nil && !httperrors.Is404(err) {
+		return err
+	}
+
+	_ = d.Set("previous_access_key", "")
+	_ = d.Set("previous_secret_key", "")
+
+	return nil
+}
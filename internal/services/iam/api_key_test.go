@@ -1,6 +1,7 @@
 package iam_test
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -225,6 +226,99 @@ func TestAccApiKey_NoUpdate(t *testing.T) {
 	})
 }
 
+func TestAccApiKey_Rotation(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	var firstAccessKey string
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckIamAPIKeyDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+						resource "scaleway_iam_application" "main" {
+							name = "tf_tests_app_key_rotation"
+						}
+
+						resource "scaleway_iam_api_key" "main" {
+							application_id = scaleway_iam_application.main.id
+							rotation_overlap = "1h"
+							rotate_when_changed = {
+								version = "1"
+							}
+						}
+					`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIamAPIKeyExists(tt, "scaleway_iam_api_key.main"),
+					resource.TestCheckResourceAttr("scaleway_iam_api_key.main", "previous_access_key", ""),
+					func(s *terraform.State) error {
+						firstAccessKey = s.RootModule().Resources["scaleway_iam_api_key.main"].Primary.ID
+
+						return nil
+					},
+				),
+			},
+			{
+				Config: `
+						resource "scaleway_iam_application" "main" {
+							name = "tf_tests_app_key_rotation"
+						}
+
+						resource "scaleway_iam_api_key" "main" {
+							application_id = scaleway_iam_application.main.id
+							rotation_overlap = "1h"
+							rotate_when_changed = {
+								version = "2"
+							}
+						}
+					`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIamAPIKeyExists(tt, "scaleway_iam_api_key.main"),
+					resource.TestCheckResourceAttrSet("scaleway_iam_api_key.main", "rotated_at"),
+					resource.TestCheckResourceAttrSet("scaleway_iam_api_key.main", "previous_secret_key"),
+					func(s *terraform.State) error {
+						rs := s.RootModule().Resources["scaleway_iam_api_key.main"]
+						if rs.Primary.Attributes["access_key"] == firstAccessKey {
+							return errors.New("api key was not rotated")
+						}
+						if rs.Primary.ID != rs.Primary.Attributes["access_key"] {
+							return fmt.Errorf("expected the ID to be the new access key %s after the rotation, got %s", rs.Primary.Attributes["access_key"], rs.Primary.ID)
+						}
+						if rs.Primary.Attributes["previous_access_key"] != firstAccessKey {
+							return fmt.Errorf("expected previous_access_key to be %s, got %s", firstAccessKey, rs.Primary.Attributes["previous_access_key"])
+						}
+
+						return nil
+					},
+				),
+			},
+			{
+				Config: `
+						resource "scaleway_iam_application" "main" {
+							name = "tf_tests_app_key_rotation"
+						}
+
+						resource "scaleway_iam_api_key" "main" {
+							application_id = scaleway_iam_application.main.id
+							rotation_overlap = "0s"
+							rotate_when_changed = {
+								version = "2"
+							}
+						}
+					`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIamAPIKeyExists(tt, "scaleway_iam_api_key.main"),
+					resource.TestCheckResourceAttr("scaleway_iam_api_key.main", "previous_access_key", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIamAPIKeyExists(tt *acctest.TestTools, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -235,7 +329,7 @@ func testAccCheckIamAPIKeyExists(tt *acctest.TestTools, name string) resource.Te
 		iamAPI := iam.NewAPI(tt.Meta)
 
 		_, err := iamAPI.GetAPIKey(&iamSDK.GetAPIKeyRequest{
-			AccessKey: rs.Primary.ID,
+			AccessKey: rs.Primary.Attributes["access_key"],
 		})
 		if err != nil {
 			return fmt.Errorf("could not find api key: %w", err)
@@ -255,7 +349,7 @@ func testAccCheckIamAPIKeyDestroy(tt *acctest.TestTools) resource.TestCheckFunc
 			iamAPI := iam.NewAPI(tt.Meta)
 
 			_, err := iamAPI.GetAPIKey(&iamSDK.GetAPIKeyRequest{
-				AccessKey: rs.Primary.ID,
+				AccessKey: rs.Primary.Attributes["access_key"],
 			})
 
 			// If no error resource still exist
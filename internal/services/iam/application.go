@@ -8,6 +8,7 @@ import (
 	iam "github.com/scaleway/scaleway-sdk-go/api/iam/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
@@ -65,7 +66,7 @@ func ResourceApplication() *schema.Resource {
 func resourceIamApplicationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := NewAPI(m)
 	app, err := api.CreateApplication(&iam.CreateApplicationRequest{
-		Name:           types.ExpandOrGenerateString(d.Get("name"), "application"),
+		Name:           meta.ExpandOrGenerateName(m, d.Get("name"), "application"),
 		Description:    d.Get("description").(string),
 		OrganizationID: d.Get("organization_id").(string),
 		Tags:           types.ExpandStrings(d.Get("tags")),
@@ -33,6 +33,55 @@ func DataSourceApplication() *schema.Resource {
 		Optional:    true,
 	}
 
+	dsSchema["api_keys"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The API keys of the application",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"access_key": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The access key of the API key",
+				},
+				"description": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The description of the API key",
+				},
+				"default_project_id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The default project ID of the API key",
+				},
+				"expires_at": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The date and time of the expiration of the API key",
+				},
+			},
+		},
+	}
+	dsSchema["policies"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The policies attached to the application",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The ID of the policy",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the policy",
+				},
+			},
+		},
+	}
+
 	return &schema.Resource{
 		ReadContext: DataSourceIamApplicationRead,
 		Schema:      dsSchema,
@@ -81,5 +130,50 @@ func DataSourceIamApplicationRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.Errorf("iam application (%s) not found", appID)
 	}
 
+	apiKeys, err := api.ListAPIKeys(&iam.ListAPIKeysRequest{
+		BearerID:   types.ExpandStringPtr(appID),
+		BearerType: iam.BearerTypeApplication,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policies, err := api.ListPolicies(&iam.ListPoliciesRequest{
+		OrganizationID: d.Get("organization_id").(string),
+		ApplicationIDs: []string{appID.(string)},
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("api_keys", flattenApplicationAPIKeys(apiKeys.APIKeys))
+	_ = d.Set("policies", flattenApplicationPolicies(policies.Policies))
+
 	return nil
 }
+
+func flattenApplicationAPIKeys(apiKeys []*iam.APIKey) []interface{} {
+	flattened := []interface{}(nil)
+	for _, apiKey := range apiKeys {
+		flattened = append(flattened, map[string]interface{}{
+			"access_key":         apiKey.AccessKey,
+			"description":        apiKey.Description,
+			"default_project_id": apiKey.DefaultProjectID,
+			"expires_at":         types.FlattenTime(apiKey.ExpiresAt),
+		})
+	}
+
+	return flattened
+}
+
+func flattenApplicationPolicies(policies []*iam.Policy) []interface{} {
+	flattened := []interface{}(nil)
+	for _, policy := range policies {
+		flattened = append(flattened, map[string]interface{}{
+			"id":   policy.ID,
+			"name": policy.Name,
+		})
+	}
+
+	return flattened
+}
@@ -80,3 +80,53 @@ func TestAccDataSourceApplication_Basic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDataSourceApplication_APIKeysAndPolicies(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckIamApplicationDestroy(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_iam_application" "main" {
+					  name = "tf_tests_data_source_api_keys"
+					}
+
+					resource "scaleway_iam_api_key" "main" {
+					  application_id = scaleway_iam_application.main.id
+					  description    = "tf_tests_data_source_api_keys"
+					}
+
+					resource "scaleway_iam_policy" "main" {
+					  name           = "tf_tests_data_source_api_keys"
+					  application_id = scaleway_iam_application.main.id
+					  rule {
+					    organization_id      = scaleway_iam_application.main.organization_id
+					    permission_set_names = ["ProjectReadOnly"]
+					  }
+					}
+
+					data "scaleway_iam_application" "main" {
+					  name       = scaleway_iam_application.main.name
+					  depends_on = [scaleway_iam_api_key.main, scaleway_iam_policy.main]
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_iam_application.main", "api_keys.#", "1"),
+					resource.TestCheckResourceAttrPair("data.scaleway_iam_application.main", "api_keys.0.access_key", "scaleway_iam_api_key.main", "access_key"),
+					resource.TestCheckResourceAttr("data.scaleway_iam_application.main", "api_keys.0.description", "tf_tests_data_source_api_keys"),
+					resource.TestCheckResourceAttr("data.scaleway_iam_application.main", "policies.#", "1"),
+					resource.TestCheckResourceAttrPair("data.scaleway_iam_application.main", "policies.0.id", "scaleway_iam_policy.main", "id"),
+					resource.TestCheckResourceAttr("data.scaleway_iam_application.main", "policies.0.name", "tf_tests_data_source_api_keys"),
+				),
+			},
+		},
+	})
+}
@@ -2,12 +2,14 @@ package iam
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	iam "github.com/scaleway/scaleway-sdk-go/api/iam/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -79,14 +81,33 @@ func ResourceGroup() *schema.Resource {
 			},
 			"organization_id": account.OrganizationIDOptionalSchema(),
 		},
+		CustomizeDiff: customizeDiffGroupExternalMembership,
 	}
 }
 
+// customizeDiffGroupExternalMembership rejects inline members on a group whose membership is managed by scaleway_iam_group_membership.
+// They would otherwise be silently ignored.
+func customizeDiffGroupExternalMembership(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.Get("external_membership").(bool) {
+		return nil
+	}
+
+	rawConfig := d.GetRawConfig()
+	for _, key := range []string{"user_ids", "application_ids"} {
+		members := rawConfig.GetAttr(key)
+		if !members.IsNull() && members.IsKnown() && members.LengthInt() > 0 {
+			return fmt.Errorf("%s cannot be set when external_membership is true, use scaleway_iam_group_membership instead", key)
+		}
+	}
+
+	return nil
+}
+
 func resourceIamGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := NewAPI(m)
 	req := &iam.CreateGroupRequest{
 		OrganizationID: d.Get("organization_id").(string),
-		Name:           types.ExpandOrGenerateString(d.Get("name"), "group"),
+		Name:           meta.ExpandOrGenerateName(m, d.Get("name"), "group"),
 		Description:    d.Get("description").(string),
 		Tags:           types.ExpandStrings(d.Get("tags")),
 	}
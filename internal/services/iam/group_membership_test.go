@@ -3,6 +3,7 @@ package iam_test
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -102,6 +103,31 @@ func TestAccGroupMembership_Basic(t *testing.T) {
 	})
 }
 
+func TestAccGroupMembership_InlineMembersConflict(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckIamGroupDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource scaleway_iam_group main {
+						name = "tf-tests-iam-group-membership-conflict"
+						external_membership = true
+						application_ids = ["11111111-1111-1111-1111-111111111111"]
+					}
+				`,
+				ExpectError: regexp.MustCompile("application_ids cannot be set when external_membership is true"),
+			},
+		},
+	})
+}
+
 func TestAccGroupMembership_User(t *testing.T) {
 	tt := acctest.NewTestTools(t)
 	defer tt.Cleanup()
@@ -9,6 +9,7 @@ import (
 	iam "github.com/scaleway/scaleway-sdk-go/api/iam/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -132,7 +133,7 @@ func resourceIamPolicyCreate(ctx context.Context, d *schema.ResourceData, m inte
 	api := NewAPI(m)
 
 	pol, err := api.CreatePolicy(&iam.CreatePolicyRequest{
-		Name:           types.ExpandOrGenerateString(d.Get("name"), "policy"),
+		Name:           meta.ExpandOrGenerateName(m, d.Get("name"), "policy"),
 		Description:    d.Get("description").(string),
 		Rules:          expandPolicyRuleSpecs(d.Get("rule")),
 		UserID:         types.ExpandStringPtr(d.Get("user_id")),
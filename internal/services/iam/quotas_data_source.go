@@ -0,0 +1,116 @@
+package iam
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	iam "github.com/scaleway/scaleway-sdk-go/api/iam/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+)
+
+func DataSourceQuotas() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceIamQuotasRead,
+		Schema: map[string]*schema.Schema{
+			"names": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "The names of the quotas to list, e.g. instances_pro2_servers_count. All the quotas are listed if empty.",
+			},
+			"quotas": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"pretty_name": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"limit": {
+							Computed:    true,
+							Type:        schema.TypeInt,
+							Description: "The maximum limit of the quota, 0 when the quota is unlimited",
+						},
+						"unlimited": {
+							Computed: true,
+							Type:     schema.TypeBool,
+						},
+						"unit": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"description": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+					},
+				},
+			},
+			"limits": {
+				Type: schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Computed:    true,
+				Description: "The limit of each quota by name, unlimited quotas are not included",
+			},
+			"organization_id": {
+				Type:        schema.TypeString,
+				Description: "The organization_id of the quotas to list",
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func DataSourceIamQuotasRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	iamAPI := NewAPI(m)
+
+	organizationID := account.GetOrganizationID(m, d)
+	if organizationID == nil {
+		return diag.Errorf("organization_id must be set to list quotas")
+	}
+
+	res, err := iamAPI.ListQuota(&iam.ListQuotaRequest{
+		OrganizationID: *organizationID,
+		QuotumNames:    types.ExpandStrings(d.Get("names")),
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	quotas := []interface{}(nil)
+	limits := make(map[string]interface{})
+	for _, quotum := range res.Quota {
+		rawQuotum := make(map[string]interface{})
+		rawQuotum["name"] = quotum.Name
+		rawQuotum["pretty_name"] = quotum.PrettyName
+		rawQuotum["unlimited"] = quotum.Unlimited != nil && *quotum.Unlimited
+		rawQuotum["unit"] = quotum.Unit
+		rawQuotum["description"] = quotum.Description
+		if quotum.Limit != nil {
+			rawQuotum["limit"] = int(*quotum.Limit)
+			limits[quotum.Name] = int(*quotum.Limit)
+		}
+
+		quotas = append(quotas, rawQuotum)
+	}
+
+	d.SetId(*organizationID)
+	_ = d.Set("organization_id", *organizationID)
+	_ = d.Set("quotas", quotas)
+	_ = d.Set("limits", limits)
+
+	return nil
+}
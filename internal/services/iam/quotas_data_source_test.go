@@ -0,0 +1,39 @@
+package iam_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccDataSourceQuotas_Basic(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "scaleway_iam_quotas" "all" {
+					  organization_id = "105bdce1-64c0-48ab-899d-868455867ecf"
+					}
+
+					data "scaleway_iam_quotas" "lbs" {
+					  organization_id = "105bdce1-64c0-48ab-899d-868455867ecf"
+					  names           = ["lbs_count"]
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scaleway_iam_quotas.all", "quotas.#"),
+					resource.TestCheckResourceAttrSet("data.scaleway_iam_quotas.all", "quotas.0.name"),
+					resource.TestCheckResourceAttr("data.scaleway_iam_quotas.lbs", "quotas.#", "1"),
+					resource.TestCheckResourceAttr("data.scaleway_iam_quotas.lbs", "quotas.0.name", "lbs_count"),
+				),
+			},
+		},
+	})
+}
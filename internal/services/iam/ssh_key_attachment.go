@@ -0,0 +1,223 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	iam "github.com/scaleway/scaleway-sdk-go/api/iam/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+// ResourceSSHKeyAttachment propagates an existing SSH key to another project.
+// An SSH key belongs to a single project, so the attachment is a copy of the key in the target project.
+func ResourceSSHKeyAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIamSSHKeyAttachmentCreate,
+		ReadContext:   resourceIamSSHKeyAttachmentRead,
+		UpdateContext: resourceIamSSHKeyAttachmentUpdate,
+		DeleteContext: resourceIamSSHKeyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"ssh_key_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "The ID of the SSH key to propagate",
+				ValidateDiagFunc: verify.IsUUID(),
+			},
+			"project_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "The ID of the project the SSH key is propagated to",
+				ValidateDiagFunc: verify.IsUUID(),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the SSH key in the project, defaults to the name of the propagated SSH key",
+			},
+			"public_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The public SSH key",
+			},
+			"fingerprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The fingerprint of the SSH key",
+			},
+			"organization_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the organization of the project",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the propagation of the SSH key",
+			},
+		},
+		CustomizeDiff: customizeDiffSSHKeyAttachmentProject,
+	}
+}
+
+// customizeDiffSSHKeyAttachmentProject checks that the SSH key is propagated to another project than its own
+func customizeDiffSSHKeyAttachmentProject(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if !diff.NewValueKnown("ssh_key_id") || !diff.NewValueKnown("project_id") {
+		return nil
+	}
+	if diff.Id() != "" && !diff.HasChanges("ssh_key_id", "project_id") {
+		return nil
+	}
+
+	sshKey, err := NewAPI(m).GetSSHKey(&iam.GetSSHKeyRequest{
+		SSHKeyID: diff.Get("ssh_key_id").(string),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if httperrors.Is404(err) {
+			return nil
+		}
+		return err
+	}
+
+	if sshKey.ProjectID == diff.Get("project_id").(string) {
+		return fmt.Errorf("SSH key %s already belongs to project %s, project_id must be another project", sshKey.ID, sshKey.ProjectID)
+	}
+
+	return nil
+}
+
+func resourceIamSSHKeyAttachmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := NewAPI(m)
+
+	sshKey, err := api.GetSSHKey(&iam.GetSSHKeyRequest{
+		SSHKeyID: d.Get("ssh_key_id").(string),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := sshKey.Name
+	if rawName, ok := d.GetOk("name"); ok {
+		name = rawName.(string)
+	}
+
+	res, err := api.CreateSSHKey(&iam.CreateSSHKeyRequest{
+		Name:      name,
+		PublicKey: sshKey.PublicKey,
+		ProjectID: d.Get("project_id").(string),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(res.ID)
+
+	return resourceIamSSHKeyAttachmentRead(ctx, d, m)
+}
+
+func resourceIamSSHKeyAttachmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := NewAPI(m)
+
+	res, err := api.GetSSHKey(&iam.GetSSHKeyRequest{
+		SSHKeyID: d.Id(),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if httperrors.Is404(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	sourceSSHKeyID, err := findPropagatedSSHKeyID(ctx, api, res, d.Get("ssh_key_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("ssh_key_id", sourceSSHKeyID)
+	_ = d.Set("name", res.Name)
+	_ = d.Set("public_key", res.PublicKey)
+	_ = d.Set("fingerprint", res.Fingerprint)
+	_ = d.Set("organization_id", res.OrganizationID)
+	_ = d.Set("project_id", res.ProjectID)
+	_ = d.Set("created_at", types.FlattenTime(res.CreatedAt))
+
+	return nil
+}
+
+func resourceIamSSHKeyAttachmentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := NewAPI(m)
+
+	if d.HasChange("name") {
+		_, err := api.UpdateSSHKey(&iam.UpdateSSHKeyRequest{
+			SSHKeyID: d.Id(),
+			Name:     types.ExpandStringPtr(d.Get("name")),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIamSSHKeyAttachmentRead(ctx, d, m)
+}
+
+func resourceIamSSHKeyAttachmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := NewAPI(m)
+
+	err := api.DeleteSSHKey(&iam.DeleteSSHKeyRequest{
+		SSHKeyID: d.Id(),
+	}, scw.WithContext(ctx))
+	if err != nil && !httperrors.Is404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// findPropagatedSSHKeyID returns the ID of the SSH key the copy was propagated from: the expected SSH key when it still has the same fingerprint,
+// or the oldest SSH key of another project of the organization with this fingerprint. It returns an empty ID when there is none.
+func findPropagatedSSHKeyID(ctx context.Context, api *iam.API, sshKeyCopy *iam.SSHKey, expectedID string) (string, error) {
+	if expectedID != "" {
+		sshKey, err := api.GetSSHKey(&iam.GetSSHKeyRequest{
+			SSHKeyID: expectedID,
+		}, scw.WithContext(ctx))
+		if err != nil && !httperrors.Is404(err) {
+			return "", err
+		}
+		if err == nil && sshKey.Fingerprint == sshKeyCopy.Fingerprint {
+			return sshKey.ID, nil
+		}
+	}
+
+	res, err := api.ListSSHKeys(&iam.ListSSHKeysRequest{
+		OrganizationID: &sshKeyCopy.OrganizationID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return "", err
+	}
+
+	sshKeys := res.SSHKeys
+	sort.SliceStable(sshKeys, func(i, j int) bool {
+		return sshKeys[i].CreatedAt != nil && sshKeys[j].CreatedAt != nil && sshKeys[i].CreatedAt.Before(*sshKeys[j].CreatedAt)
+	})
+
+	for _, sshKey := range sshKeys {
+		if sshKey.ID != sshKeyCopy.ID && sshKey.ProjectID != sshKeyCopy.ProjectID && sshKey.Fingerprint == sshKeyCopy.Fingerprint {
+			return sshKey.ID, nil
+		}
+	}
+
+	return "", nil
+}
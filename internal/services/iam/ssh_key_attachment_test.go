@@ -0,0 +1,76 @@
+package iam_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	iamchecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/iam/testfuncs"
+)
+
+func TestAccSSHKeyAttachment_Basic(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      iamchecks.CheckSSHKeyDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_account_project" "other" {
+						name = "tf_tests_iam_ssh_key_attachment"
+					}
+
+					resource "scaleway_iam_ssh_key" "main" {
+						name       = "tf-test-iam-ssh-key-attachment"
+						public_key = "%s"
+					}
+
+					resource "scaleway_iam_ssh_key_attachment" "other" {
+						ssh_key_id = scaleway_iam_ssh_key.main.id
+						project_id = scaleway_account_project.other.id
+					}
+				`, SSHKey),
+				Check: resource.ComposeTestCheckFunc(
+					iamchecks.CheckSSHKeyExists(tt, "scaleway_iam_ssh_key_attachment.other"),
+					resource.TestCheckResourceAttr("scaleway_iam_ssh_key_attachment.other", "name", "tf-test-iam-ssh-key-attachment"),
+					resource.TestCheckResourceAttrPair("scaleway_iam_ssh_key_attachment.other", "public_key", "scaleway_iam_ssh_key.main", "public_key"),
+					resource.TestCheckResourceAttrPair("scaleway_iam_ssh_key_attachment.other", "fingerprint", "scaleway_iam_ssh_key.main", "fingerprint"),
+					resource.TestCheckResourceAttrPair("scaleway_iam_ssh_key_attachment.other", "project_id", "scaleway_account_project.other", "id"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_account_project" "other" {
+						name = "tf_tests_iam_ssh_key_attachment"
+					}
+
+					resource "scaleway_iam_ssh_key" "main" {
+						name       = "tf-test-iam-ssh-key-attachment"
+						public_key = "%s"
+					}
+
+					resource "scaleway_iam_ssh_key_attachment" "other" {
+						ssh_key_id = scaleway_iam_ssh_key.main.id
+						project_id = scaleway_account_project.other.id
+						name       = "tf-test-iam-ssh-key-attachment-other"
+					}
+				`, SSHKey),
+				Check: resource.ComposeTestCheckFunc(
+					iamchecks.CheckSSHKeyExists(tt, "scaleway_iam_ssh_key_attachment.other"),
+					resource.TestCheckResourceAttr("scaleway_iam_ssh_key_attachment.other", "name", "tf-test-iam-ssh-key-attachment-other"),
+				),
+			},
+			{
+				ResourceName:      "scaleway_iam_ssh_key_attachment.other",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
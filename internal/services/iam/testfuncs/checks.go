@@ -14,7 +14,7 @@ import (
 func CheckSSHKeyDestroy(tt *acctest.TestTools) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		for _, rs := range state.RootModule().Resources {
-			if rs.Type != "scaleway_iam_ssh_key" {
+			if rs.Type != "scaleway_iam_ssh_key" && rs.Type != "scaleway_iam_ssh_key_attachment" {
 				continue
 			}
 
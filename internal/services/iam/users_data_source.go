@@ -0,0 +1,123 @@
+package iam
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	iam "github.com/scaleway/scaleway-sdk-go/api/iam/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+)
+
+func DataSourceUsers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceIamUsersRead,
+		Schema: map[string]*schema.Schema{
+			"mfa": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Users with this MFA status are listed, use false to list the users without MFA.",
+			},
+			"tag": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Users with a tag containing this string are listed.",
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"email": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"username": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"type": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"status": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"mfa": {
+							Computed: true,
+							Type:     schema.TypeBool,
+						},
+						"locked": {
+							Computed: true,
+							Type:     schema.TypeBool,
+						},
+						"last_login_at": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"tags": {
+							Computed: true,
+							Type:     schema.TypeList,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"organization_id": {
+				Type:        schema.TypeString,
+				Description: "The organization_id of the users to list",
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func DataSourceIamUsersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	iamAPI := NewAPI(m)
+
+	organizationID := account.GetOrganizationID(m, d)
+	if organizationID == nil {
+		return diag.Errorf("organization_id must be set to list users")
+	}
+
+	res, err := iamAPI.ListUsers(&iam.ListUsersRequest{
+		OrganizationID: organizationID,
+		Mfa:            types.ExpandBoolPtr(types.GetBool(d, "mfa")),
+		Tag:            types.ExpandStringPtr(d.Get("tag")),
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	users := []interface{}(nil)
+	for _, user := range res.Users {
+		rawUser := make(map[string]interface{})
+		rawUser["id"] = user.ID
+		rawUser["email"] = user.Email
+		rawUser["username"] = user.Username
+		rawUser["type"] = user.Type.String()
+		rawUser["status"] = user.Status.String()
+		rawUser["mfa"] = user.Mfa
+		rawUser["locked"] = user.Locked
+		rawUser["last_login_at"] = types.FlattenTime(user.LastLoginAt)
+		rawUser["tags"] = types.FlattenSliceString(user.Tags)
+
+		users = append(users, rawUser)
+	}
+
+	d.SetId(*organizationID)
+	_ = d.Set("organization_id", *organizationID)
+	_ = d.Set("users", users)
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package iam_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccDataSourceUsers_MFA(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "scaleway_iam_users" "all" {
+					  organization_id = "105bdce1-64c0-48ab-899d-868455867ecf"
+					}
+
+					data "scaleway_iam_users" "with_mfa" {
+					  organization_id = "105bdce1-64c0-48ab-899d-868455867ecf"
+					  mfa             = true
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scaleway_iam_users.all", "users.#"),
+					resource.TestCheckResourceAttrSet("data.scaleway_iam_users.all", "users.0.email"),
+					resource.TestCheckResourceAttr("data.scaleway_iam_users.with_mfa", "users.0.mfa", "true"),
+				),
+			},
+		},
+	})
+}
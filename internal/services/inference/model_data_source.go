@@ -0,0 +1,176 @@
+package inference
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	inference "github.com/scaleway/scaleway-sdk-go/api/inference/v1beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+func DataSourceModel() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceModelRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the model",
+				ConflictsWith: []string{"model_id"},
+			},
+			"model_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "The ID of the model",
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+				ConflictsWith:    []string{"name", "quantization_level"},
+			},
+			"quantization_level": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The quantization level of the model (e.g. fp8, bf16)",
+				ConflictsWith: []string{"model_id"},
+			},
+			"project_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "The project ID owning the model, leave empty to look up public models",
+				ValidateDiagFunc: verify.IsUUID(),
+			},
+			"provider_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The provider of the model",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the model",
+			},
+			"tags": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "The tags associated with the model",
+			},
+			"has_eula": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the model requires an EULA to be accepted before deployment",
+			},
+			"is_public": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the model is a public model of the catalog",
+			},
+			"compatible_node_types": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "The node types the model can be deployed on",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the creation of the model",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the last update of the model",
+			},
+			"region": regional.Schema(),
+		},
+	}
+}
+
+func DataSourceModelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, region, err := NewAPIWithRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var model *inference.Model
+
+	if modelID, ok := d.GetOk("model_id"); ok {
+		model, err = api.GetModel(&inference.GetModelRequest{
+			Region:  region,
+			ModelID: locality.ExpandID(modelID),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		name, nameOk := d.GetOk("name")
+		if !nameOk {
+			return diag.Errorf("one of name or model_id must be set")
+		}
+
+		res, err := api.ListModels(&inference.ListModelsRequest{
+			Region:    region,
+			Name:      types.ExpandStringPtr(name),
+			ProjectID: types.ExpandStringPtr(d.Get("project_id")),
+		}, scw.WithAllPages(), scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		model, err = findModel(res.Models, name.(string), d.Get("quantization_level").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(datasource.NewRegionalID(model.ID, region))
+	_ = d.Set("model_id", d.Id())
+	_ = d.Set("name", model.Name)
+	_ = d.Set("quantization_level", model.QuantizationLevel)
+	_ = d.Set("project_id", model.ProjectID)
+	_ = d.Set("provider_name", model.Provider)
+	_ = d.Set("description", model.Description)
+	_ = d.Set("tags", model.Tags)
+	_ = d.Set("has_eula", model.HasEula)
+	_ = d.Set("is_public", model.IsPublic)
+	_ = d.Set("compatible_node_types", model.CompatibleNodeTypes)
+	_ = d.Set("created_at", types.FlattenTime(model.CreatedAt))
+	_ = d.Set("updated_at", types.FlattenTime(model.UpdatedAt))
+	_ = d.Set("region", region)
+
+	return nil
+}
+
+// findModel returns the model with the exact given name, and quantization level if set
+func findModel(models []*inference.Model, name string, quantizationLevel string) (*inference.Model, error) {
+	var matches []*inference.Model
+
+	for _, model := range models {
+		if model.Name != name {
+			continue
+		}
+		if quantizationLevel != "" && model.QuantizationLevel != quantizationLevel {
+			continue
+		}
+		matches = append(matches, model)
+	}
+
+	switch {
+	case len(matches) == 0 && quantizationLevel != "":
+		return nil, fmt.Errorf("no model found with the name %s and quantization level %s", name, quantizationLevel)
+	case len(matches) == 0:
+		return nil, fmt.Errorf("no model found with the name %s", name)
+	case len(matches) > 1:
+		return nil, fmt.Errorf("%d models found with the name %s, set quantization_level to select one", len(matches), name)
+	}
+
+	return matches[0], nil
+}
@@ -0,0 +1,40 @@
+package inference_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccDataSourceModel_Basic(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "scaleway_inference_model" "by_name" {
+						name = "meta/llama-3.1-8b-instruct:fp8"
+					}
+
+					data "scaleway_inference_model" "by_id" {
+						model_id = data.scaleway_inference_model.by_name.model_id
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_inference_model.by_name", "quantization_level", "fp8"),
+					resource.TestCheckResourceAttr("data.scaleway_inference_model.by_name", "is_public", "true"),
+					resource.TestCheckResourceAttrSet("data.scaleway_inference_model.by_name", "compatible_node_types.#"),
+					resource.TestCheckResourceAttrPair("data.scaleway_inference_model.by_id", "name", "data.scaleway_inference_model.by_name", "name"),
+					resource.TestCheckResourceAttrPair("data.scaleway_inference_model.by_id", "quantization_level", "data.scaleway_inference_model.by_name", "quantization_level"),
+				),
+			},
+		},
+	})
+}
@@ -2,6 +2,7 @@ package inferencetestfuncs
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	inference "github.com/scaleway/scaleway-sdk-go/api/inference/v1beta1"
@@ -11,8 +12,8 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_instance_deployment", &resource.Sweeper{
-		Name:         "scaleway_instance_deployment",
+	resource.AddTestSweepers("scaleway_inference_deployment", &resource.Sweeper{
+		Name:         "scaleway_inference_deployment",
 		Dependencies: nil,
 		F:            testSweepDeployment,
 	})
@@ -31,6 +32,10 @@ func testSweepDeployment(_ string) error {
 		}
 
 		for _, deployment := range listDeployments.Deployments {
+			if !isTestDeployment(deployment) || !acctest.IsOldEnoughToSweep(deployment.CreatedAt) {
+				continue
+			}
+
 			_, err := inferenceAPI.DeleteDeployment(&inference.DeleteDeploymentRequest{
 				DeploymentID: deployment.ID,
 				Region:       region,
@@ -45,3 +50,10 @@ func testSweepDeployment(_ string) error {
 		return nil
 	})
 }
+
+// isTestDeployment returns whether the deployment was created by the tests, which name their deployments test-inference-*
+func isTestDeployment(deployment *inference.Deployment) bool {
+	return acctest.IsTestResource(deployment.Name) ||
+		strings.HasPrefix(deployment.Name, "test-inference") ||
+		acctest.HasTestTag(deployment.Tags)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"github.com/scaleway/scaleway-sdk-go/api/vpc/v2"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
@@ -44,6 +45,8 @@ const (
 
 	defaultInstanceSnapshotWaitTimeout = 1 * time.Hour
 
+	defaultFinalSnapshotName = "{volume_name}-final-{timestamp}"
+
 	defaultInstanceImageTimeout = 1 * time.Hour
 )
 
@@ -140,7 +143,8 @@ func serverStateExpand(rawState string) (instance.ServerState, error) {
 	return apiState, nil
 }
 
-func reachState(ctx context.Context, api *BlockAndInstanceAPI, zone scw.Zone, serverID string, toState instance.ServerState) error {
+// reachState performs the actions needed to move the server to the wanted state, waiting up to timeout for each of them
+func reachState(ctx context.Context, api *BlockAndInstanceAPI, zone scw.Zone, serverID string, toState instance.ServerState, timeout time.Duration) error {
 	response, err := api.GetServer(&instance.GetServerRequest{
 		Zone:     zone,
 		ServerID: serverID,
@@ -196,9 +200,9 @@ func reachState(ctx context.Context, api *BlockAndInstanceAPI, zone scw.Zone, se
 			ServerID:      serverID,
 			Action:        a,
 			Zone:          zone,
-			Timeout:       scw.TimeDurationPtr(DefaultInstanceServerWaitTimeout),
+			Timeout:       scw.TimeDurationPtr(timeout),
 			RetryInterval: transport.DefaultWaitRetryInterval,
-		})
+		}, scw.WithContext(ctx))
 		if err != nil {
 			return err
 		}
@@ -500,13 +504,14 @@ func instanceServerAdditionalVolumeTemplate(api *BlockAndInstanceAPI, zone scw.Z
 	return vol.VolumeTemplate(), nil
 }
 
-func prepareRootVolume(rootVolumeI map[string]any, serverType *instance.ServerType, image string) *UnknownVolume {
+func prepareRootVolume(m interface{}, rootVolumeI map[string]any, serverType *instance.ServerType, image string) *UnknownVolume {
 	serverTypeCanBootOnBlock := serverType.VolumesConstraint.MaxSize == 0
 
 	rootVolumeIsBootVolume := types.ExpandBoolPtr(types.GetMapValue[bool](rootVolumeI, "boot"))
 	rootVolumeType := types.GetMapValue[string](rootVolumeI, "volume_type")
 	sizeInput := types.GetMapValue[int](rootVolumeI, "size_in_gb")
 	rootVolumeID := zonal.ExpandID(types.GetMapValue[string](rootVolumeI, "volume_id")).ID
+	rootVolumeSnapshotID := zonal.ExpandID(types.GetMapValue[string](rootVolumeI, "snapshot_id")).ID
 
 	// If the rootVolumeType is not defined, define it depending on the offer
 	if rootVolumeType == "" {
@@ -519,7 +524,7 @@ func prepareRootVolume(rootVolumeI map[string]any, serverType *instance.ServerTy
 
 	rootVolumeName := ""
 	if image == "" { // When creating an instance from an image, volume should not have a name
-		rootVolumeName = types.NewRandomName("vol")
+		rootVolumeName = meta.ExpandOrGenerateName(m, "", "vol")
 	}
 
 	var rootVolumeSize *scw.Size
@@ -538,5 +543,26 @@ func prepareRootVolume(rootVolumeI map[string]any, serverType *instance.ServerTy
 		InstanceVolumeType: instance.VolumeVolumeType(rootVolumeType),
 		Size:               rootVolumeSize,
 		Boot:               rootVolumeIsBootVolume,
+		BaseSnapshotID:     rootVolumeSnapshotID,
 	}
 }
+
+// findServerIDByName returns the ID of the server with the given name, used to import servers by name
+func findServerIDByName(ctx context.Context, m interface{}, zone scw.Zone, name string) (string, error) {
+	res, err := instance.NewAPI(meta.ExtractScwClient(m)).ListServers(&instance.ListServersRequest{
+		Zone: zone,
+		Name: &name,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	server, err := datasource.FindExact(res.Servers, func(s *instance.Server) bool {
+		return s.Name == name
+	}, name)
+	if err != nil {
+		return "", err
+	}
+
+	return server.ID, nil
+}
@@ -36,6 +36,9 @@ type UnknownVolume struct {
 	ServerID *string
 	Boot     *bool
 
+	// BaseSnapshotID is only used to create a volume from a snapshot.
+	BaseSnapshotID string
+
 	// Iops is set for Block volume only, use IsBlockVolume
 	// Can be nil if not available in the Block API.
 	Iops *uint32
@@ -55,6 +58,9 @@ func (volume *UnknownVolume) VolumeTemplate() *instance.VolumeServerTemplate {
 	} else {
 		template.VolumeType = volume.InstanceVolumeType
 		template.Size = volume.Size
+		if volume.BaseSnapshotID != "" {
+			template.BaseSnapshot = &volume.BaseSnapshotID
+		}
 	}
 
 	if volume.Boot != nil {
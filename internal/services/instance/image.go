@@ -11,6 +11,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/cdf"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
@@ -183,7 +184,7 @@ func ResourceInstanceImageCreate(ctx context.Context, d *schema.ResourceData, m
 
 	req := &instanceSDK.CreateImageRequest{
 		Zone:       zone,
-		Name:       types.ExpandOrGenerateString(d.Get("name"), "image"),
+		Name:       meta.ExpandOrGenerateName(m, d.Get("name"), "image"),
 		RootVolume: zonal.ExpandID(d.Get("root_volume_id").(string)).ID,
 		Arch:       instanceSDK.Arch(d.Get("architecture").(string)),
 		Project:    types.ExpandStringPtr(d.Get("project_id")),
@@ -2,6 +2,8 @@ package instance
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -44,23 +46,37 @@ func ResourceIP() *schema.Resource {
 				Optional:    true,
 				Description: "The type of instance IP",
 				ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
-					if i.(string) == "nat" {
+					switch instanceSDK.IPType(i.(string)) {
+					case instanceSDK.IPTypeRoutedIPv4, instanceSDK.IPTypeRoutedIPv6:
+						return nil
+					case instanceSDK.IPTypeNat:
 						return diag.Diagnostics{{
 							Severity:      diag.Error,
 							Summary:       "NAT IPs are not supported anymore",
 							Detail:        "Remove explicit nat configuration, migrate to routed ips or downgrade terraform.\nLearn more about migration: https://www.scaleway.com/en/docs/compute/instances/how-to/migrate-routed-ips/",
 							AttributePath: path,
 						}}
+					default:
+						return diag.Diagnostics{{
+							Severity:      diag.Error,
+							Summary:       "Invalid IP type",
+							Detail:        fmt.Sprintf("expected type to be one of %s or %s, got %s", instanceSDK.IPTypeRoutedIPv4, instanceSDK.IPTypeRoutedIPv6, i),
+							AttributePath: path,
+						}}
 					}
-
-					return nil
 				},
 			},
 			"reverse": {
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
 				Description: "The reverse DNS for this IP",
 			},
+			"is_ipv6": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the IP is an IPv6 prefix",
+			},
 			"server_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -79,6 +95,12 @@ func ResourceIP() *schema.Resource {
 			"project_id":      account.ProjectIDSchema(),
 		},
 		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+			// The reverse of the addresses of an IPv6 prefix are managed with IPAM
+			if reverse, ok := diff.GetOk("reverse"); ok && diff.HasChange("reverse") && reverse.(string) != "" &&
+				instanceSDK.IPType(diff.Get("type").(string)) == instanceSDK.IPTypeRoutedIPv6 {
+				return errors.New("reverse cannot be set on routed_ipv6 IPs, use scaleway_ipam_ip_reverse_dns to manage the reverse DNS of the prefix addresses")
+			}
+
 			// The only allowed change is
 			// nat -> routed_ipv4
 			if diff.HasChange("type") {
@@ -153,6 +175,15 @@ func ResourceInstanceIPUpdate(ctx context.Context, d *schema.ResourceData, m int
 		req.Type = instanceSDK.IPType(d.Get("type").(string))
 	}
 
+	if d.HasChange("reverse") {
+		reverse := d.Get("reverse").(string)
+		if reverse == "" {
+			req.Reverse = &instanceSDK.NullableStringValue{Null: true}
+		} else {
+			req.Reverse = &instanceSDK.NullableStringValue{Value: reverse}
+		}
+	}
+
 	_, err = instanceAPI.UpdateIP(req, scw.WithContext(ctx))
 	if err != nil {
 		return diag.FromErr(err)
@@ -198,6 +229,7 @@ func ResourceInstanceIPRead(ctx context.Context, d *schema.ResourceData, m inter
 	_ = d.Set("project_id", res.IP.Project)
 	_ = d.Set("reverse", res.IP.Reverse)
 	_ = d.Set("type", res.IP.Type)
+	_ = d.Set("is_ipv6", res.IP.Type == instanceSDK.IPTypeRoutedIPv6)
 
 	if len(res.IP.Tags) > 0 {
 		_ = d.Set("tags", types.FlattenSliceString(res.IP.Tags))
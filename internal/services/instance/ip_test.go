@@ -115,6 +115,7 @@ func TestAccIP_RoutedIPV6(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					instancechecks.CheckIPExists(tt, "scaleway_instance_ip.main"),
 					resource.TestCheckResourceAttr("scaleway_instance_ip.main", "type", "routed_ipv6"),
+					resource.TestCheckResourceAttr("scaleway_instance_ip.main", "is_ipv6", "true"),
 					resource.TestCheckResourceAttrSet("scaleway_instance_ip.main", "address"),
 					resource.TestCheckResourceAttrSet("scaleway_instance_ip.main", "prefix"),
 					isIPValid("scaleway_instance_ip.main", "address"),
@@ -125,6 +126,58 @@ func TestAccIP_RoutedIPV6(t *testing.T) {
 	})
 }
 
+func TestAccIP_Reverse(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	testDNSZone := "tf-reverse-instance-ip." + acctest.TestDomain
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      instancechecks.IsIPDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_instance_ip" "main" {}
+
+					resource "scaleway_domain_record" "tf_A" {
+						dns_zone = %[1]q
+						name     = ""
+						type     = "A"
+						data     = scaleway_instance_ip.main.address
+						ttl      = 3600
+						priority = 1
+					}
+				`, testDNSZone),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_instance_ip" "main" {
+						reverse = %[1]q
+					}
+
+					resource "scaleway_domain_record" "tf_A" {
+						dns_zone = %[1]q
+						name     = ""
+						type     = "A"
+						data     = scaleway_instance_ip.main.address
+						ttl      = 3600
+						priority = 1
+					}
+				`, testDNSZone),
+				Check: resource.ComposeTestCheckFunc(
+					instancechecks.CheckIPExists(tt, "scaleway_instance_ip.main"),
+					resource.TestCheckResourceAttr("scaleway_instance_ip.main", "reverse", testDNSZone),
+					resource.TestCheckResourceAttr("scaleway_instance_ip.main", "is_ipv6", "false"),
+				),
+			},
+		},
+	})
+}
+
 func isIPCIDRValid(name string, key string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -0,0 +1,144 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+func DataSourceIPs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceInstanceIPsRead,
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: verify.ValidateEnum[instance.IPType](),
+				Description:      "IPs with this type are listed.",
+			},
+			"tags": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "IPs with these exact tags are listed.",
+			},
+			"state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: verify.ValidateEnum[instance.IPState](),
+				Description:      "IPs in this state are listed, use detached to list the IPs that are not attached to a server.",
+			},
+			"ips": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"address": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"prefix": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"type": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"state": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"server_id": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"tags": {
+							Computed: true,
+							Type:     schema.TypeList,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"zone":            zonal.Schema(),
+						"organization_id": account.OrganizationIDSchema(),
+						"project_id":      account.ProjectIDSchema(),
+					},
+				},
+			},
+			"zone":            zonal.Schema(),
+			"organization_id": account.OrganizationIDSchema(),
+			"project_id":      account.ProjectIDSchema(),
+		},
+	}
+}
+
+func DataSourceInstanceIPsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	instanceAPI, zone, err := newAPIWithZone(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := instanceAPI.ListIPs(&instance.ListIPsRequest{
+		Zone:    zone,
+		Project: types.ExpandStringPtr(d.Get("project_id")),
+		Tags:    types.ExpandStrings(d.Get("tags")),
+		Type:    types.ExpandStringPtr(d.Get("type")),
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The API does not allow to filter IPs on their state
+	state := instance.IPState(d.Get("state").(string))
+
+	ips := []interface{}(nil)
+	for _, ip := range res.IPs {
+		if state != "" && ip.State != state {
+			continue
+		}
+
+		address := ip.Address.String()
+		if address == types.NetIPNil {
+			address = ip.Prefix.IP.String()
+		}
+
+		rawIP := make(map[string]interface{})
+		rawIP["id"] = zonal.NewIDString(ip.Zone, ip.ID)
+		rawIP["address"] = address
+		if prefix := ip.Prefix.String(); prefix != types.NetIPNil {
+			rawIP["prefix"] = prefix
+		}
+		rawIP["type"] = ip.Type.String()
+		rawIP["state"] = ip.State.String()
+		if ip.Server != nil {
+			rawIP["server_id"] = zonal.NewIDString(ip.Zone, ip.Server.ID)
+		}
+		if len(ip.Tags) > 0 {
+			rawIP["tags"] = ip.Tags
+		}
+		rawIP["zone"] = ip.Zone.String()
+		rawIP["organization_id"] = ip.Organization
+		rawIP["project_id"] = ip.Project
+
+		ips = append(ips, rawIP)
+	}
+
+	d.SetId(zone.String())
+	_ = d.Set("ips", ips)
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package instance_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	instancechecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/instance/testfuncs"
+)
+
+func TestAccDataSourceIPs_Basic(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      instancechecks.IsIPDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_instance_ip" "detached" {
+						tags = [ "terraform-test", "data_scaleway_instance_ips", "basic" ]
+					}
+
+					resource "scaleway_instance_ip" "attached" {
+						tags = [ "terraform-test", "data_scaleway_instance_ips", "basic" ]
+					}
+
+					resource "scaleway_instance_server" "main" {
+						image = "ubuntu_jammy"
+						type  = "DEV1-S"
+						state = "stopped"
+						ip_id = scaleway_instance_ip.attached.id
+					}`,
+			},
+			{
+				Config: `
+					resource "scaleway_instance_ip" "detached" {
+						tags = [ "terraform-test", "data_scaleway_instance_ips", "basic" ]
+					}
+
+					resource "scaleway_instance_ip" "attached" {
+						tags = [ "terraform-test", "data_scaleway_instance_ips", "basic" ]
+					}
+
+					resource "scaleway_instance_server" "main" {
+						image = "ubuntu_jammy"
+						type  = "DEV1-S"
+						state = "stopped"
+						ip_id = scaleway_instance_ip.attached.id
+					}
+
+					data "scaleway_instance_ips" "by_tags" {
+						tags = [ "data_scaleway_instance_ips", "basic" ]
+					}
+
+					data "scaleway_instance_ips" "detached" {
+						tags  = [ "data_scaleway_instance_ips", "basic" ]
+						state = "detached"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_instance_ips.by_tags", "ips.#", "2"),
+					resource.TestCheckResourceAttr("data.scaleway_instance_ips.detached", "ips.#", "1"),
+					resource.TestCheckResourceAttrPair("data.scaleway_instance_ips.detached", "ips.0.id", "scaleway_instance_ip.detached", "id"),
+					resource.TestCheckResourceAttrPair("data.scaleway_instance_ips.detached", "ips.0.address", "scaleway_instance_ip.detached", "address"),
+					resource.TestCheckResourceAttr("data.scaleway_instance_ips.detached", "ips.0.server_id", ""),
+				),
+			},
+		},
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -76,7 +77,7 @@ func ResourceInstancePlacementGroupCreate(ctx context.Context, d *schema.Resourc
 
 	res, err := instanceAPI.CreatePlacementGroup(&instanceSDK.CreatePlacementGroupRequest{
 		Zone:       zone,
-		Name:       types.ExpandOrGenerateString(d.Get("name"), "pg"),
+		Name:       meta.ExpandOrGenerateName(m, d.Get("name"), "pg"),
 		Project:    types.ExpandStringPtr(d.Get("project_id")),
 		PolicyMode: instanceSDK.PlacementGroupPolicyMode(d.Get("policy_mode").(string)),
 		PolicyType: instanceSDK.PlacementGroupPolicyType(d.Get("policy_type").(string)),
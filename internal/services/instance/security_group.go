@@ -13,6 +13,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -110,7 +111,7 @@ func ResourceInstanceSecurityGroupCreate(ctx context.Context, d *schema.Resource
 	}
 
 	req := &instanceSDK.CreateSecurityGroupRequest{
-		Name:                  types.ExpandOrGenerateString(d.Get("name"), "sg"),
+		Name:                  meta.ExpandOrGenerateName(m, d.Get("name"), "sg"),
 		Zone:                  zone,
 		Project:               types.ExpandStringPtr(d.Get("project_id")),
 		Description:           d.Get("description").(string),
@@ -42,7 +42,7 @@ func ResourceServer() *schema.Resource {
 		UpdateContext: ResourceInstanceServerUpdate,
 		DeleteContext: ResourceInstanceServerDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: zonal.ImportStateByName(findServerIDByName),
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create:  schema.DefaultTimeout(DefaultInstanceServerWaitTimeout),
@@ -64,7 +64,7 @@ func ResourceServer() *schema.Resource {
 				Optional:         true,
 				Description:      "The UUID or the label of the base image used by the server",
 				DiffSuppressFunc: dsf.Locality,
-				ExactlyOneOf:     []string{"image", "root_volume.0.volume_id"},
+				ExactlyOneOf:     []string{"image", "root_volume.0.volume_id", "root_volume.0.snapshot_id"},
 			},
 			"type": {
 				Type:             schema.TypeString,
@@ -104,6 +104,11 @@ func ResourceServer() *schema.Resource {
 				Computed:    true,
 				Description: "True when the placement group policy is respected",
 			},
+			"hypervisor_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the hypervisor the server is running on",
+			},
 			"root_volume": {
 				Type:        schema.TypeList,
 				MaxItems:    1,
@@ -149,7 +154,16 @@ func ResourceServer() *schema.Resource {
 							Computed:     true,
 							Optional:     true,
 							Description:  "Volume ID of the root volume",
-							ExactlyOneOf: []string{"image", "root_volume.0.volume_id"},
+							ExactlyOneOf: []string{"image", "root_volume.0.volume_id", "root_volume.0.snapshot_id"},
+						},
+						"snapshot_id": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							Description:      "ID of the snapshot the root volume is created from",
+							ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+							DiffSuppressFunc: dsf.Locality,
+							ExactlyOneOf:     []string{"image", "root_volume.0.volume_id", "root_volume.0.snapshot_id"},
 						},
 						"sbs_iops": {
 							Type:        schema.TypeInt,
@@ -395,7 +409,7 @@ func ResourceInstanceServerCreate(ctx context.Context, d *schema.ResourceData, m
 
 	req := &instanceSDK.CreateServerRequest{
 		Zone:              zone,
-		Name:              types.ExpandOrGenerateString(d.Get("name"), "srv"),
+		Name:              meta.ExpandOrGenerateName(m, d.Get("name"), "srv"),
 		Project:           types.ExpandStringPtr(d.Get("project_id")),
 		CommercialType:    commercialType,
 		SecurityGroup:     types.ExpandStringPtr(zonal.ExpandID(d.Get("security_group_id")).ID),
@@ -439,7 +453,7 @@ func ResourceInstanceServerCreate(ctx context.Context, d *schema.ResourceData, m
 	req.Volumes = make(map[string]*instanceSDK.VolumeServerTemplate)
 	rootVolume := d.Get("root_volume.0").(map[string]any)
 
-	req.Volumes["0"] = prepareRootVolume(rootVolume, serverType, imageUUID).VolumeTemplate()
+	req.Volumes["0"] = prepareRootVolume(m, rootVolume, serverType, imageUUID).VolumeTemplate()
 	if raw, ok := d.GetOk("additional_volume_ids"); ok {
 		for i, volumeID := range raw.([]interface{}) {
 			// We have to get the volume to know whether it is a local or a block volume
@@ -537,7 +551,7 @@ func ResourceInstanceServerCreate(ctx context.Context, d *schema.ResourceData, m
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	err = reachState(ctx, api, zone, res.Server.ID, targetState)
+	err = reachState(ctx, api, zone, res.Server.ID, targetState, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -639,6 +653,10 @@ func ResourceInstanceServerRead(ctx context.Context, d *schema.ResourceData, m i
 			_ = d.Set("placement_group_policy_respected", server.PlacementGroup.PolicyRespected)
 		}
 
+		if server.Location != nil {
+			_ = d.Set("hypervisor_id", server.Location.HypervisorID)
+		}
+
 		if server.PrivateIP != nil {
 			_ = d.Set("private_ip", types.FlattenStringPtr(server.PrivateIP))
 		}
@@ -722,6 +740,8 @@ func ResourceInstanceServerRead(ctx context.Context, d *schema.ResourceData, m i
 				rootVolume["volume_type"] = volume.VolumeType
 				rootVolume["boot"] = volume.Boot
 				rootVolume["name"] = volume.Name
+				// The API does not return the snapshot the volume was created from
+				rootVolume["snapshot_id"] = d.Get("root_volume.0.snapshot_id")
 
 				_ = d.Set("root_volume", []map[string]interface{}{rootVolume})
 			} else {
@@ -1005,20 +1025,26 @@ func ResourceInstanceServerUpdate(ctx context.Context, d *schema.ResourceData, m
 	// Apply changes
 	////
 
-	if d.HasChange("state") {
-		targetState, err := serverStateExpand(d.Get("state").(string))
+	// A server being powered on while its volumes change is started once they are attached,
+	// any other state change is applied before the server is updated.
+	startAfterUpdate := wantedState == InstanceServerStateStarted && updateRequest.Volumes != nil
+	if d.HasChange("state") && !startAfterUpdate {
+		err = resourceInstanceServerReachState(ctx, d, api, zone, id)
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		// reach expected state
-		err = reachState(ctx, api, zone, id, targetState)
+	}
+
+	if serverShouldUpdate {
+		_, err = api.UpdateServer(updateRequest)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
-	if serverShouldUpdate {
-		_, err = api.UpdateServer(updateRequest)
+	if d.HasChange("state") && startAfterUpdate {
+		// reachState waits for the volumes to be available before powering the server on
+		err = resourceInstanceServerReachState(ctx, d, api, zone, id)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -1050,6 +1076,16 @@ func ResourceInstanceServerUpdate(ctx context.Context, d *schema.ResourceData, m
 	return append(warnings, ResourceInstanceServerRead(ctx, d, m)...)
 }
 
+// resourceInstanceServerReachState moves the server to the state of the configuration
+func resourceInstanceServerReachState(ctx context.Context, d *schema.ResourceData, api *BlockAndInstanceAPI, zone scw.Zone, id string) error {
+	targetState, err := serverStateExpand(d.Get("state").(string))
+	if err != nil {
+		return err
+	}
+
+	return reachState(ctx, api, zone, id, targetState, d.Timeout(schema.TimeoutUpdate))
+}
+
 func ResourceInstanceServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, id, err := instanceAndBlockAPIWithZoneAndID(m, d.Id())
 	if err != nil {
@@ -1078,7 +1114,7 @@ func ResourceInstanceServerDelete(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 	// reach stopped state
-	err = reachState(ctx, api, zone, id, instanceSDK.ServerStateStopped)
+	err = reachState(ctx, api, zone, id, instanceSDK.ServerStateStopped, d.Timeout(schema.TimeoutDelete))
 	if httperrors.Is404(err) {
 		return nil
 	}
@@ -1262,7 +1298,7 @@ func ResourceInstanceServerMigrate(ctx context.Context, d *schema.ResourceData,
 	}
 	beginningState := server.State
 
-	err = reachState(ctx, api, zone, id, instanceSDK.ServerStateStopped)
+	err = reachState(ctx, api, zone, id, instanceSDK.ServerStateStopped, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return fmt.Errorf("failed to stop server before changing server type: %w", err)
 	}
@@ -1276,7 +1312,7 @@ func ResourceInstanceServerMigrate(ctx context.Context, d *schema.ResourceData,
 		return errors.New("failed to change server type server")
 	}
 
-	err = reachState(ctx, api, zone, id, beginningState)
+	err = reachState(ctx, api, zone, id, beginningState, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return fmt.Errorf("failed to start server after changing server type: %w", err)
 	}
@@ -790,6 +790,38 @@ func TestAccServer_Basic2(t *testing.T) {
 	})
 }
 
+func TestAccServer_ImportByName(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      instancechecks.IsServerDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_instance_server" "main" {
+					  name  = "tf-tests-instance-server-import-by-name"
+					  image = "ubuntu_focal"
+					  type  = "DEV1-S"
+					}`,
+				Check: isServerPresent(tt, "scaleway_instance_server.main"),
+			},
+			{
+				ResourceName:      "scaleway_instance_server.main",
+				ImportState:       true,
+				ImportStateId:     "fr-par-1/tf-tests-instance-server-import-by-name",
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"replace_on_type_change",
+					"root_volume.0.boot",
+				},
+			},
+		},
+	})
+}
+
 func TestAccServer_WithReservedIP(t *testing.T) {
 	tt := acctest.NewTestTools(t)
 	defer tt.Cleanup()
@@ -1902,6 +1934,51 @@ func TestAccServer_RootVolumeFromExternalSnapshot(t *testing.T) {
 	})
 }
 
+func TestAccServer_RootVolumeFromSnapshotID(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      instancechecks.IsServerDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_instance_server" "main" {
+						name = "tf-tests-instance-root-volume-from-snapshot-id"
+						image = "ubuntu_jammy"
+						type  = "PLAY2-PICO"
+						root_volume {
+							volume_type = "sbs_volume"
+							size_in_gb = 20
+						}
+					}
+
+					resource "scaleway_block_snapshot" "snapshot" {
+						volume_id = scaleway_instance_server.main.root_volume.0.volume_id
+					}
+
+					resource "scaleway_instance_server" "from_snapshot" {
+						name = "tf-tests-instance-root-volume-from-snapshot-id-2"
+						type  = "PLAY2-PICO"
+						root_volume {
+							volume_type = "sbs_volume"
+							snapshot_id = scaleway_block_snapshot.snapshot.id
+							size_in_gb = 30
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("scaleway_instance_server.from_snapshot", "root_volume.0.snapshot_id", "scaleway_block_snapshot.snapshot", "id"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.from_snapshot", "root_volume.0.volume_type", string(instanceSDK.VolumeVolumeTypeSbsVolume)),
+					resource.TestCheckResourceAttr("scaleway_instance_server.from_snapshot", "root_volume.0.size_in_gb", "30"),
+					resource.TestCheckResourceAttrSet("scaleway_instance_server.from_snapshot", "root_volume.0.volume_id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccServer_PrivateNetworkMissingPNIC(t *testing.T) {
 	tt := acctest.NewTestTools(t)
 	defer tt.Cleanup()
@@ -0,0 +1,154 @@
+package instance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	instanceSDK "github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/workerpool"
+)
+
+const maxServersActionWorkers = 10
+
+// serversActionStates are the states reached by the power actions, reboot is handled separately
+var serversActionStates = map[string]instanceSDK.ServerState{
+	instanceSDK.ServerActionPoweron.String():     instanceSDK.ServerStateRunning,
+	instanceSDK.ServerActionPoweroff.String():    instanceSDK.ServerStateStopped,
+	instanceSDK.ServerActionStopInPlace.String(): instanceSDK.ServerStateStoppedInPlace,
+}
+
+func ResourceServersAction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceInstanceServersActionCreate,
+		ReadContext:   ResourceInstanceServersActionRead,
+		DeleteContext: ResourceInstanceServersActionDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(DefaultInstanceServerWaitTimeout),
+			Default: schema.DefaultTimeout(DefaultInstanceServerWaitTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					instanceSDK.ServerActionPoweron.String(),
+					instanceSDK.ServerActionPoweroff.String(),
+					instanceSDK.ServerActionStopInPlace.String(),
+					instanceSDK.ServerActionReboot.String(),
+				}, false),
+				Description: "The power action to perform on the servers (poweron, poweroff, stop_in_place or reboot)",
+			},
+			"tags": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Description: "The action is performed on the servers with these exact tags",
+			},
+			"triggers": {
+				Type: schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary values, the action is performed again when they change",
+			},
+			"server_ids": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed:    true,
+				Description: "The IDs of the servers the action was performed on",
+			},
+			"zone":       zonal.Schema(),
+			"project_id": account.ProjectIDSchema(),
+		},
+	}
+}
+
+func ResourceInstanceServersActionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, zone, err := instanceAndBlockAPIWithZone(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tags := types.ExpandStrings(d.Get("tags"))
+	res, err := api.ListServers(&instanceSDK.ListServersRequest{
+		Zone:    zone,
+		Tags:    tags,
+		Project: types.ExpandStringPtr(d.Get("project_id")),
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(res.Servers) == 0 {
+		return diag.Errorf("no server found in zone %s with the tags %v", zone, tags)
+	}
+
+	action := d.Get("action").(string)
+	timeout := d.Timeout(schema.TimeoutCreate)
+
+	pool := workerpool.NewWorkerPool(min(len(res.Servers), maxServersActionWorkers))
+	serverIDs := make([]string, 0, len(res.Servers))
+	for _, server := range res.Servers {
+		serverIDs = append(serverIDs, zonal.NewIDString(zone, server.ID))
+		pool.AddTask(func() error {
+			var err error
+			if state, ok := serversActionStates[action]; ok {
+				err = reachState(ctx, api, zone, server.ID, state, timeout)
+			} else {
+				err = api.ServerActionAndWait(&instanceSDK.ServerActionAndWaitRequest{
+					ServerID:      server.ID,
+					Zone:          zone,
+					Action:        instanceSDK.ServerAction(action),
+					Timeout:       &timeout,
+					RetryInterval: transport.DefaultWaitRetryInterval,
+				}, scw.WithContext(ctx))
+			}
+			if err != nil {
+				return fmt.Errorf("failed to %s server %s (%s): %w", action, server.Name, server.ID, err)
+			}
+
+			return nil
+		})
+	}
+
+	if errs := pool.CloseAndWait(); len(errs) > 0 {
+		return diag.FromErr(errors.Join(errs...))
+	}
+
+	d.SetId(zonal.NewIDString(zone, id.UniqueId()))
+	_ = d.Set("server_ids", serverIDs)
+	_ = d.Set("zone", zone.String())
+
+	return ResourceInstanceServersActionRead(ctx, d, m)
+}
+
+// ResourceInstanceServersActionRead does nothing: the action was performed once at creation.
+func ResourceInstanceServersActionRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+// ResourceInstanceServersActionDelete only removes the action from the state, the servers are left unchanged.
+func ResourceInstanceServersActionDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	d.SetId("")
+
+	return nil
+}
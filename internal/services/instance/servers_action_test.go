@@ -0,0 +1,77 @@
+package instance_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	instanceSDK "github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/instance"
+	instancechecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/instance/testfuncs"
+)
+
+func TestAccServersAction_Poweroff(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      instancechecks.IsServerDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_instance_server" "main" {
+					  count = 2
+					  image = "ubuntu_focal"
+					  type  = "DEV1-S"
+					  tags  = [ "terraform-test", "tf-tests-instance-servers-action" ]
+					}
+
+					resource "scaleway_instance_servers_action" "poweroff" {
+					  action = "poweroff"
+					  tags   = [ "tf-tests-instance-servers-action" ]
+
+					  depends_on = [ scaleway_instance_server.main ]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scaleway_instance_servers_action.poweroff", "server_ids.#", "2"),
+					serverHasState(tt, "scaleway_instance_server.main.0", instanceSDK.ServerStateStopped),
+					serverHasState(tt, "scaleway_instance_server.main.1", instanceSDK.ServerStateStopped),
+				),
+				// The servers are managed with the default state "started", the next plan powers them back on
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func serverHasState(tt *acctest.TestTools, n string, state instanceSDK.ServerState) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", n)
+		}
+
+		instanceAPI, zone, ID, err := instance.NewAPIWithZoneAndID(tt.Meta, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		res, err := instanceAPI.GetServer(&instanceSDK.GetServerRequest{
+			Zone:     zone,
+			ServerID: ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if res.Server.State != state {
+			return fmt.Errorf("server %s is %s, expected %s", ID, res.Server.State, state)
+		}
+
+		return nil
+	}
+}
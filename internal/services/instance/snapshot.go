@@ -14,6 +14,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
@@ -121,7 +122,7 @@ func ResourceInstanceSnapshotCreate(ctx context.Context, d *schema.ResourceData,
 	req := &instanceSDK.CreateSnapshotRequest{
 		Zone:    zone,
 		Project: types.ExpandStringPtr(d.Get("project_id")),
-		Name:    types.ExpandOrGenerateString(d.Get("name"), "snap"),
+		Name:    meta.ExpandOrGenerateName(m, d.Get("name"), "snap"),
 	}
 
 	if volumeType, ok := d.GetOk("type"); ok {
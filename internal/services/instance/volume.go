@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,6 +15,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
@@ -75,6 +78,26 @@ func ResourceVolume() *schema.Resource {
 				Optional:    true,
 				Description: "The tags associated with the volume",
 			},
+			"snapshot_before_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Create a final snapshot of the volume before deleting it",
+			},
+			"final_snapshot_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultFinalSnapshotName,
+				Description: "The name of the final snapshot, {volume_name} and {timestamp} are replaced by the name of the volume and the deletion date",
+			},
+			"final_snapshot_tags": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "The tags associated with the final snapshot, for example to define its retention",
+			},
 			"organization_id": account.OrganizationIDSchema(),
 			"project_id":      account.ProjectIDSchema(),
 			"zone":            zonal.Schema(),
@@ -91,7 +114,7 @@ func ResourceInstanceVolumeCreate(ctx context.Context, d *schema.ResourceData, m
 
 	createVolumeRequest := &instanceSDK.CreateVolumeRequest{
 		Zone:       zone,
-		Name:       types.ExpandOrGenerateString(d.Get("name"), "vol"),
+		Name:       meta.ExpandOrGenerateName(m, d.Get("name"), "vol"),
 		VolumeType: instanceSDK.VolumeVolumeType(d.Get("type").(string)),
 		Project:    types.ExpandStringPtr(d.Get("project_id")),
 	}
@@ -249,6 +272,13 @@ func ResourceInstanceVolumeDelete(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(errors.New("volume is still attached to a server"))
 	}
 
+	if d.Get("snapshot_before_delete").(bool) {
+		err = createFinalSnapshot(ctx, instanceAPI, volume, d.Get("final_snapshot_name").(string), types.ExpandStrings(d.Get("final_snapshot_tags")), d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	deleteRequest := &instanceSDK.DeleteVolumeRequest{
 		Zone:     zone,
 		VolumeID: id,
@@ -261,3 +291,35 @@ func ResourceInstanceVolumeDelete(ctx context.Context, d *schema.ResourceData, m
 
 	return nil
 }
+
+// createFinalSnapshot creates a snapshot of the volume and waits for it to be available.
+func createFinalSnapshot(ctx context.Context, api *instanceSDK.API, volume *instanceSDK.Volume, nameTemplate string, tags []string, timeout time.Duration) error {
+	req := &instanceSDK.CreateSnapshotRequest{
+		Zone:     volume.Zone,
+		Name:     expandFinalSnapshotName(nameTemplate, volume.Name, time.Now()),
+		VolumeID: &volume.ID,
+		Project:  &volume.Project,
+	}
+	if len(tags) > 0 {
+		req.Tags = &tags
+	}
+
+	res, err := api.CreateSnapshot(req, scw.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("couldn't create final snapshot of volume %s: %w", volume.ID, err)
+	}
+
+	_, err = waitForSnapshot(ctx, api, volume.Zone, res.Snapshot.ID, timeout)
+	if err != nil {
+		return fmt.Errorf("couldn't create final snapshot of volume %s: %w", volume.ID, err)
+	}
+
+	return nil
+}
+
+func expandFinalSnapshotName(nameTemplate string, volumeName string, date time.Time) string {
+	return strings.NewReplacer(
+		"{volume_name}", volumeName,
+		"{timestamp}", date.UTC().Format("20060102-150405"),
+	).Replace(nameTemplate)
+}
@@ -3,11 +3,13 @@ package instance_test
 import (
 	"fmt"
 	"regexp"
+	"slices"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	instanceSDK "github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
@@ -193,6 +195,90 @@ func TestAccVolume_Scratch(t *testing.T) {
 	})
 }
 
+func TestAccVolume_SnapshotBeforeDelete(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			isFinalSnapshotCreated(tt, "tf-test-volume-final", "retention=7d"),
+			isVolumeDestroyed(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_instance_volume" "main" {
+						name                   = "tf-test-volume"
+						type                   = "b_ssd"
+						size_in_gb             = 20
+						snapshot_before_delete = true
+						final_snapshot_name    = "{volume_name}-final"
+						final_snapshot_tags    = ["retention=7d"]
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					isVolumePresent(tt, "scaleway_instance_volume.main"),
+					resource.TestCheckResourceAttr("scaleway_instance_volume.main", "snapshot_before_delete", "true"),
+				),
+			},
+		},
+	})
+}
+
+// isFinalSnapshotCreated checks that the final snapshot of a volume exists with the expected name and tag.
+// Every snapshot matching the name is deleted, even when the check fails, so that the test does not leak them.
+func isFinalSnapshotCreated(tt *acctest.TestTools, name string, tag string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		instanceAPI := instanceSDK.NewAPI(tt.Meta.ScwClient())
+		for _, rs := range state.RootModule().Resources {
+			if rs.Type != "scaleway_instance_volume" {
+				continue
+			}
+
+			zone, _, err := zonal.ParseID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			snapshots, err := instanceAPI.ListSnapshots(&instanceSDK.ListSnapshotsRequest{
+				Zone: zone,
+				Name: &name,
+			}, scw.WithAllPages())
+			if err != nil {
+				return err
+			}
+
+			for _, snapshot := range snapshots.Snapshots {
+				err = instanceAPI.DeleteSnapshot(&instanceSDK.DeleteSnapshotRequest{
+					Zone:       zone,
+					SnapshotID: snapshot.ID,
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			if len(snapshots.Snapshots) != 1 {
+				return fmt.Errorf("expected 1 final snapshot named %s, got %d", name, len(snapshots.Snapshots))
+			}
+
+			snapshot := snapshots.Snapshots[0]
+			if snapshot.Name != name {
+				return fmt.Errorf("expected final snapshot to be named %s, got %s", name, snapshot.Name)
+			}
+
+			if !slices.Contains(snapshot.Tags, tag) {
+				return fmt.Errorf("expected final snapshot %s to be tagged %s, got %v", snapshot.ID, tag, snapshot.Tags)
+			}
+		}
+
+		return nil
+	}
+}
+
 func isVolumePresent(tt *acctest.TestTools, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
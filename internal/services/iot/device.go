@@ -13,6 +13,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -190,7 +191,7 @@ func ResourceIotDeviceCreate(ctx context.Context, d *schema.ResourceData, m inte
 	req := &iot.CreateDeviceRequest{
 		Region: region,
 		HubID:  locality.ExpandID(d.Get("hub_id")),
-		Name:   types.ExpandOrGenerateString(d.Get("name"), "device"),
+		Name:   meta.ExpandOrGenerateName(m, d.Get("name"), "device"),
 	}
 
 	if allowInsecure, ok := d.GetOk("allow_insecure"); ok {
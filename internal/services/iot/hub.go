@@ -12,8 +12,8 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
-	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
 
@@ -132,7 +132,7 @@ func ResourceIotHubCreate(ctx context.Context, d *schema.ResourceData, m interfa
 	}
 	req := &iot.CreateHubRequest{
 		Region:      region,
-		Name:        types.ExpandOrGenerateString(d.Get("name"), "hub"),
+		Name:        meta.ExpandOrGenerateName(m, d.Get("name"), "hub"),
 		ProductPlan: iot.HubProductPlan(d.Get("product_plan").(string)),
 	}
 
@@ -13,7 +13,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
-	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
 
@@ -87,7 +87,7 @@ func ResourceIotNetworkCreate(ctx context.Context, d *schema.ResourceData, m int
 
 	req := &iot.CreateNetworkRequest{
 		Region: region,
-		Name:   types.ExpandOrGenerateString(d.Get("name"), "network"),
+		Name:   meta.ExpandOrGenerateName(m, d.Get("name"), "network"),
 		Type:   iot.NetworkNetworkType(d.Get("type").(string)),
 		HubID:  locality.ExpandID(d.Get("hub_id")),
 	}
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/iot/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -13,6 +14,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -21,12 +23,14 @@ func ResourceRoute() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: ResourceIotRouteCreate,
 		ReadContext:   ResourceIotRouteRead,
+		UpdateContext: ResourceIotRouteUpdate,
 		DeleteContext: ResourceIotRouteDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create:  schema.DefaultTimeout(defaultIoTHubTimeout),
+			Update:  schema.DefaultTimeout(defaultIoTHubTimeout),
 			Default: schema.DefaultTimeout(defaultIoTHubTimeout),
 		},
 		SchemaVersion: 0,
@@ -34,7 +38,6 @@ func ResourceRoute() *schema.Resource {
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 				Description: "The name of the route",
 			},
 			"hub_id": {
@@ -47,7 +50,6 @@ func ResourceRoute() *schema.Resource {
 			"topic": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 				Description: "The Topic the route subscribes to (wildcards allowed)",
 			},
 			"database": {
@@ -55,7 +57,6 @@ func ResourceRoute() *schema.Resource {
 				MinItems:    1,
 				MaxItems:    1,
 				Optional:    true,
-				ForceNew:    true,
 				Description: "Database Route parameters",
 				ExactlyOneOf: []string{
 					iot.RouteRouteTypeDatabase.String(),
@@ -64,40 +65,41 @@ func ResourceRoute() *schema.Resource {
 				},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"engine": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							Description:      "The database engine, postgresql or mysql",
+							ValidateDiagFunc: verify.ValidateEnum[iot.RouteDatabaseConfigEngine](),
+						},
 						"query": {
 							Type:        schema.TypeString,
 							Required:    true,
-							ForceNew:    true,
 							Description: "SQL query to be executed ($TOPIC and $PAYLOAD variables are available, see documentation)",
 						},
 						"host": {
 							Type:        schema.TypeString,
 							Required:    true,
-							ForceNew:    true,
 							Description: "The database hostname",
 						},
 						"port": {
 							Type:        schema.TypeInt,
 							Required:    true,
-							ForceNew:    true,
 							Description: "The database port",
 						},
 						"dbname": {
 							Type:        schema.TypeString,
 							Required:    true,
-							ForceNew:    true,
 							Description: "The database name",
 						},
 						"username": {
 							Type:        schema.TypeString,
 							Required:    true,
-							ForceNew:    true,
 							Description: "The database username",
 						},
 						"password": {
 							Type:        schema.TypeString,
 							Required:    true,
-							ForceNew:    true,
 							Description: "The database password",
 							Sensitive:   true,
 						},
@@ -109,31 +111,26 @@ func ResourceRoute() *schema.Resource {
 				MinItems:    1,
 				MaxItems:    1,
 				Optional:    true,
-				ForceNew:    true,
 				Description: "Rest Route parameters",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"verb": {
 							Type:             schema.TypeString,
 							Required:         true,
-							ForceNew:         true,
 							Description:      "The HTTP Verb used to call REST URI",
 							ValidateDiagFunc: verify.ValidateEnum[iot.RouteRestConfigHTTPVerb](),
 						},
 						"uri": {
 							Type:        schema.TypeString,
 							Required:    true,
-							ForceNew:    true,
 							Description: "The URI of the REST endpoint",
 						},
 						"headers": {
 							Type:        schema.TypeMap,
 							Required:    true,
-							ForceNew:    true,
 							Description: "The HTTP call extra headers",
 							Elem: &schema.Schema{
-								Type:     schema.TypeString,
-								ForceNew: true,
+								Type: schema.TypeString,
 							},
 						},
 					},
@@ -144,32 +141,27 @@ func ResourceRoute() *schema.Resource {
 				MinItems:    1,
 				MaxItems:    1,
 				Optional:    true,
-				ForceNew:    true,
 				Description: "S3 Route parameters",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"bucket_region": {
 							Type:        schema.TypeString,
 							Required:    true,
-							ForceNew:    true,
 							Description: "The region of the S3 route's destination bucket",
 						},
 						"bucket_name": {
 							Type:        schema.TypeString,
 							Required:    true,
-							ForceNew:    true,
 							Description: "The name of the S3 route's destination bucket",
 						},
 						"object_prefix": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							ForceNew:    true,
 							Description: "The string to prefix object names with",
 						},
 						"strategy": {
 							Type:             schema.TypeString,
 							Required:         true,
-							ForceNew:         true,
 							Description:      "How the S3 route's objects will be created: one per topic or one per message",
 							ValidateDiagFunc: verify.ValidateEnum[iot.RouteS3ConfigS3Strategy](),
 						},
@@ -184,7 +176,13 @@ func ResourceRoute() *schema.Resource {
 				Description: "The date and time of the creation of the IoT Route",
 			},
 		},
-		CustomizeDiff: cdf.LocalityCheck("hub_id"),
+		CustomizeDiff: customdiff.All(
+			cdf.LocalityCheck("hub_id"),
+			// The type of a route cannot be updated
+			customdiff.ForceNewIfChange(iot.RouteRouteTypeDatabase.String(), isRouteTypeChanged),
+			customdiff.ForceNewIfChange(iot.RouteRouteTypeRest.String(), isRouteTypeChanged),
+			customdiff.ForceNewIfChange(iot.RouteRouteTypeS3.String(), isRouteTypeChanged),
+		),
 	}
 }
 
@@ -202,7 +200,7 @@ func ResourceIotRouteCreate(ctx context.Context, d *schema.ResourceData, m inter
 
 	req := &iot.CreateRouteRequest{
 		Region: region,
-		Name:   types.ExpandOrGenerateString(d.Get("name"), "route"),
+		Name:   meta.ExpandOrGenerateName(m, d.Get("name"), "route"),
 		HubID:  zonal.ExpandID(d.Get("hub_id")).ID,
 		Topic:  d.Get("topic").(string),
 	}
@@ -231,6 +229,7 @@ func ResourceIotRouteCreate(ctx context.Context, d *schema.ResourceData, m inter
 			Username: d.Get(prefixKey + ".username").(string),
 			Password: d.Get(prefixKey + ".password").(string),
 			Query:    d.Get(prefixKey + ".query").(string),
+			Engine:   iot.RouteDatabaseConfigEngine(d.Get(prefixKey + ".engine").(string)),
 		}
 	} else {
 		return diag.FromErr(errors.New("no route type have been chosen"))
@@ -278,6 +277,7 @@ func ResourceIotRouteRead(ctx context.Context, d *schema.ResourceData, m interfa
 	switch response.Type {
 	case iot.RouteRouteTypeDatabase:
 		conf := []map[string]interface{}{{
+			"engine":   response.DbConfig.Engine.String(),
 			"query":    response.DbConfig.Query,
 			"host":     response.DbConfig.Host,
 			"port":     int(response.DbConfig.Port),
@@ -307,6 +307,79 @@ func ResourceIotRouteRead(ctx context.Context, d *schema.ResourceData, m interfa
 	return nil
 }
 
+func ResourceIotRouteUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	iotAPI, region, routeID, err := NewAPIWithRegionAndID(m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := &iot.UpdateRouteRequest{
+		Region:  region,
+		RouteID: routeID,
+	}
+
+	if d.HasChange("name") {
+		req.Name = types.ExpandStringPtr(d.Get("name"))
+	}
+
+	if d.HasChange("topic") {
+		req.Topic = types.ExpandStringPtr(d.Get("topic"))
+	}
+
+	switch {
+	case d.HasChange(iot.RouteRouteTypeS3.String()):
+		prefixKey := iot.RouteRouteTypeS3.String() + ".0"
+		req.S3Config = &iot.UpdateRouteRequestS3Config{
+			BucketRegion: types.ExpandStringPtr(d.Get(prefixKey + ".bucket_region")),
+			BucketName:   types.ExpandStringPtr(d.Get(prefixKey + ".bucket_name")),
+			ObjectPrefix: scw.StringPtr(d.Get(prefixKey + ".object_prefix").(string)),
+			Strategy:     iot.RouteS3ConfigS3Strategy(d.Get(prefixKey + ".strategy").(string)),
+		}
+	case d.HasChange(iot.RouteRouteTypeRest.String()):
+		prefixKey := iot.RouteRouteTypeRest.String() + ".0"
+		headers := extractRestHeaders(d, prefixKey+".headers")
+		req.RestConfig = &iot.UpdateRouteRequestRestConfig{
+			Verb:    iot.RouteRestConfigHTTPVerb(d.Get(prefixKey + ".verb").(string)),
+			URI:     types.ExpandStringPtr(d.Get(prefixKey + ".uri")),
+			Headers: &headers,
+		}
+	case d.HasChange(iot.RouteRouteTypeDatabase.String()):
+		prefixKey := iot.RouteRouteTypeDatabase.String() + ".0"
+		req.DbConfig = &iot.UpdateRouteRequestDatabaseConfig{
+			Host:     types.ExpandStringPtr(d.Get(prefixKey + ".host")),
+			Port:     types.ExpandUint32Ptr(d.Get(prefixKey + ".port")),
+			Dbname:   types.ExpandStringPtr(d.Get(prefixKey + ".dbname")),
+			Username: types.ExpandStringPtr(d.Get(prefixKey + ".username")),
+			Password: types.ExpandStringPtr(d.Get(prefixKey + ".password")),
+			Query:    types.ExpandStringPtr(d.Get(prefixKey + ".query")),
+			Engine:   iot.RouteDatabaseConfigEngine(d.Get(prefixKey + ".engine").(string)),
+		}
+	}
+
+	hubID := zonal.ExpandID(d.Get("hub_id")).ID
+	_, err = waitIotHub(ctx, iotAPI, region, hubID, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = iotAPI.UpdateRoute(req, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = waitIotHub(ctx, iotAPI, region, hubID, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ResourceIotRouteRead(ctx, d, m)
+}
+
+// isRouteTypeChanged returns true when a route configuration block is added or removed, meaning the type of the route changed.
+func isRouteTypeChanged(_ context.Context, oldValue, newValue, _ interface{}) bool {
+	return len(oldValue.([]interface{})) != len(newValue.([]interface{}))
+}
+
 func ResourceIotRouteDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	iotAPI, region, routeID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
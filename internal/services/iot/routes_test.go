@@ -185,6 +185,77 @@ func TestAccRoute_REST(t *testing.T) {
 	})
 }
 
+func TestAccRoute_UpdateREST(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		// Destruction is done via the hub destruction.
+		CheckDestroy: isHubDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+						resource "scaleway_iot_hub" "minimal" {
+							name         = "minimal"
+							product_plan = "plan_shared"
+						}
+
+						resource "scaleway_iot_route" "default" {
+							name   = "default"
+							hub_id = scaleway_iot_hub.minimal.id
+							topic  = "#"
+
+							rest {
+								verb = "get"
+								uri  = "http://scaleway.com"
+								headers = {
+									X-terraform-test = "inprogress"
+								}
+							}
+						}
+						`,
+				Check: resource.ComposeTestCheckFunc(
+					isRoutePresent(tt, "scaleway_iot_route.default"),
+					resource.TestCheckResourceAttr("scaleway_iot_route.default", "rest.0.verb", "get"),
+				),
+			},
+			{
+				Config: `
+						resource "scaleway_iot_hub" "minimal" {
+							name         = "minimal"
+							product_plan = "plan_shared"
+						}
+
+						resource "scaleway_iot_route" "default" {
+							name   = "updated"
+							hub_id = scaleway_iot_hub.minimal.id
+							topic  = "sensors/#"
+
+							rest {
+								verb = "post"
+								uri  = "http://scaleway.com/ingest"
+								headers = {
+									X-terraform-test = "updated"
+								}
+							}
+						}
+						`,
+				Check: resource.ComposeTestCheckFunc(
+					isRoutePresent(tt, "scaleway_iot_route.default"),
+					resource.TestCheckResourceAttr("scaleway_iot_route.default", "name", "updated"),
+					resource.TestCheckResourceAttr("scaleway_iot_route.default", "topic", "sensors/#"),
+					resource.TestCheckResourceAttr("scaleway_iot_route.default", "rest.0.verb", "post"),
+					resource.TestCheckResourceAttr("scaleway_iot_route.default", "rest.0.uri", "http://scaleway.com/ingest"),
+					resource.TestCheckResourceAttr("scaleway_iot_route.default", "rest.0.headers.X-terraform-test", "updated"),
+				),
+			},
+		},
+	})
+}
+
 func isRoutePresent(tt *acctest.TestTools, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
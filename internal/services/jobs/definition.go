@@ -13,6 +13,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
@@ -39,12 +40,24 @@ func ResourceDefinition() *schema.Resource {
 				Optional: true,
 			},
 			"cpu_limit": {
-				Type:     schema.TypeInt,
-				Required: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"cpu_limit", "resource_preset"},
+				RequiredWith: []string{"memory_limit"},
 			},
 			"memory_limit": {
-				Type:     schema.TypeInt,
-				Required: true,
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				RequiredWith:  []string{"cpu_limit"},
+				ConflictsWith: []string{"resource_preset"},
+			},
+			"resource_preset": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(jobsResourcePresets, false),
+				Description:  "A named preset of the resources available in the region, used instead of cpu_limit and memory_limit",
 			},
 			"image_uri": {
 				Type:     schema.TypeString,
@@ -102,7 +115,7 @@ func ResourceJobDefinitionCreate(ctx context.Context, d *schema.ResourceData, m
 
 	req := &jobs.CreateJobDefinitionRequest{
 		Region:               region,
-		Name:                 types.ExpandOrGenerateString(d.Get("name").(string), "job"),
+		Name:                 meta.ExpandOrGenerateName(m, d.Get("name").(string), "job"),
 		CPULimit:             uint32(d.Get("cpu_limit").(int)),
 		MemoryLimit:          uint32(d.Get("memory_limit").(int)),
 		ImageURI:             d.Get("image_uri").(string),
@@ -113,6 +126,16 @@ func ResourceJobDefinitionCreate(ctx context.Context, d *schema.ResourceData, m
 		CronSchedule:         expandJobDefinitionCron(d.Get("cron")).ToCreateRequest(),
 	}
 
+	if preset, ok := d.GetOk("resource_preset"); ok {
+		resource, err := findJobsResourcePreset(ctx, api, region, preset.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		req.CPULimit = resource.CPULimit
+		req.MemoryLimit = resource.MemoryLimit
+	}
+
 	if timeoutSeconds, ok := d.GetOk("timeout"); ok {
 		duration, err := time.ParseDuration(timeoutSeconds.(string))
 		if err != nil {
@@ -193,6 +216,16 @@ func ResourceJobDefinitionUpdate(ctx context.Context, d *schema.ResourceData, m
 		req.MemoryLimit = types.ExpandUint32Ptr(d.Get("memory_limit"))
 	}
 
+	if preset, ok := d.GetOk("resource_preset"); ok && d.HasChange("resource_preset") {
+		resource, err := findJobsResourcePreset(ctx, api, region, preset.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		req.CPULimit = &resource.CPULimit
+		req.MemoryLimit = &resource.MemoryLimit
+	}
+
 	if d.HasChange("image_uri") {
 		req.ImageURI = types.ExpandUpdatedStringPtr(d.Get("image_uri"))
 	}
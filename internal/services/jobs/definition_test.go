@@ -160,6 +160,64 @@ func TestAccJobDefinition_Cron(t *testing.T) {
 	})
 }
 
+func TestAccJobDefinition_ResourcePreset(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckJobDefinitionDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource scaleway_job_definition main {
+						name = "test-jobs-job-definition-preset"
+						resource_preset = "small"
+						image_uri = "docker.io/alpine:latest"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobDefinitionExists(tt, "scaleway_job_definition.main"),
+					resource.TestCheckResourceAttr("scaleway_job_definition.main", "resource_preset", "small"),
+					resource.TestCheckResourceAttrSet("scaleway_job_definition.main", "cpu_limit"),
+					resource.TestCheckResourceAttrSet("scaleway_job_definition.main", "memory_limit"),
+				),
+			},
+			{
+				Config: `
+					resource scaleway_job_definition main {
+						name = "test-jobs-job-definition-preset"
+						resource_preset = "large"
+						image_uri = "docker.io/alpine:latest"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobDefinitionExists(tt, "scaleway_job_definition.main"),
+					resource.TestCheckResourceAttr("scaleway_job_definition.main", "resource_preset", "large"),
+				),
+			},
+			{
+				Config: `
+					resource scaleway_job_definition main {
+						name = "test-jobs-job-definition-preset"
+						cpu_limit = 120
+						memory_limit = 256
+						image_uri = "docker.io/alpine:latest"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobDefinitionExists(tt, "scaleway_job_definition.main"),
+					resource.TestCheckResourceAttr("scaleway_job_definition.main", "cpu_limit", "120"),
+					resource.TestCheckResourceAttr("scaleway_job_definition.main", "memory_limit", "256"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckJobDefinitionExists(tt *acctest.TestTools, n string) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		rs, ok := state.RootModule().Resources[n]
@@ -1,11 +1,24 @@
 package jobs
 
 import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	jobs "github.com/scaleway/scaleway-sdk-go/api/jobs/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+)
+
+const (
+	defaultJobRunTimeout       = 15 * time.Minute
+	defaultJobRunRetryInterval = 15 * time.Second
 )
 
 // newAPIWithRegion returns a new jobs API and the region for a Create request
@@ -32,6 +45,44 @@ func NewAPIWithRegionAndID(m interface{}, regionalID string) (*jobs.API, scw.Reg
 	return jobsAPI, region, ID, nil
 }
 
+// jobsResourcePresets are the names of the presets of resource_preset, from the smallest to the largest resources.
+var jobsResourcePresets = []string{"small", "medium", "large"}
+
+// findJobsResourcePreset returns the resources of a preset among the resources accepted by the Jobs API in the region.
+// The smallest and the largest resources are the small and large presets, medium is the median of the resources.
+func findJobsResourcePreset(ctx context.Context, api *jobs.API, region scw.Region, preset string) (*jobs.Resource, error) {
+	res, err := api.ListJobsResources(&jobs.ListJobsResourcesRequest{
+		Region: region,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Resources) == 0 {
+		return nil, fmt.Errorf("no job resources available in region %s", region)
+	}
+
+	resources := res.Resources
+	sort.Slice(resources, func(i, j int) bool {
+		if resources[i].CPULimit != resources[j].CPULimit {
+			return resources[i].CPULimit < resources[j].CPULimit
+		}
+
+		return resources[i].MemoryLimit < resources[j].MemoryLimit
+	})
+
+	switch preset {
+	case "small":
+		return resources[0], nil
+	case "medium":
+		return resources[(len(resources)-1)/2], nil
+	case "large":
+		return resources[len(resources)-1], nil
+	default:
+		return nil, fmt.Errorf("unknown resource preset %q, expected one of %s", preset, strings.Join(jobsResourcePresets, ", "))
+	}
+}
+
 type JobDefinitionCron struct {
 	Schedule string
 	Timezone string
@@ -87,3 +138,54 @@ func flattenJobDefinitionCron(cron *jobs.CronSchedule) []any {
 		},
 	}
 }
+
+func waitForJobRun(ctx context.Context, api *jobs.API, region scw.Region, id string, timeout time.Duration) (*jobs.JobRun, error) {
+	retryInterval := defaultJobRunRetryInterval
+	if transport.DefaultWaitRetryInterval != nil {
+		retryInterval = *transport.DefaultWaitRetryInterval
+	}
+
+	return api.WaitForJobRun(&jobs.WaitForJobRunRequest{
+		JobRunID:      id,
+		Region:        region,
+		Timeout:       scw.TimeDurationPtr(timeout),
+		RetryInterval: &retryInterval,
+	}, scw.WithContext(ctx))
+}
+
+// isJobRunTerminated returns true if the job run will not change state anymore
+func isJobRunTerminated(state jobs.JobRunState) bool {
+	switch state {
+	case jobs.JobRunStateSucceeded, jobs.JobRunStateFailed, jobs.JobRunStateCanceled, jobs.JobRunStateInternalError:
+		return true
+	default:
+		return false
+	}
+}
+
+func flattenJobRun(run *jobs.JobRun) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                regional.NewIDString(run.Region, run.ID),
+		"job_definition_id": regional.NewIDString(run.Region, run.JobDefinitionID),
+		"state":             run.State.String(),
+		"command":           run.Command,
+		"env":               types.FlattenMap(run.EnvironmentVariables),
+		"cpu_limit":         int(run.CPULimit),
+		"memory_limit":      int(run.MemoryLimit),
+		"exit_code":         types.FlattenInt32Ptr(run.ExitCode),
+		"error_message":     run.ErrorMessage,
+		"run_duration":      flattenJobRunDuration(run.RunDuration),
+		"created_at":        types.FlattenTime(run.CreatedAt),
+		"started_at":        types.FlattenTime(run.StartedAt),
+		"terminated_at":     types.FlattenTime(run.TerminatedAt),
+		"region":            run.Region.String(),
+	}
+}
+
+func flattenJobRunDuration(duration *scw.Duration) string {
+	if duration == nil {
+		return ""
+	}
+
+	return duration.ToTimeDuration().String()
+}
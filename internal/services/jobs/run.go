@@ -0,0 +1,232 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	jobs "github.com/scaleway/scaleway-sdk-go/api/jobs/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/cdf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+// ResourceRun starts a run of a job definition when created.
+// Every argument forces a new run.
+func ResourceRun() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceJobRunCreate,
+		ReadContext:   ResourceJobRunRead,
+		DeleteContext: ResourceJobRunDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(defaultJobRunTimeout),
+			Delete:  schema.DefaultTimeout(defaultJobRunTimeout),
+			Default: schema.DefaultTimeout(defaultJobRunTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"job_definition_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "The ID of the job definition to run",
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: dsf.Locality,
+			},
+			"command": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The startup command of this run, defaults to the command of the job definition",
+			},
+			"env": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The environment variables of this run, merged with the ones of the job definition",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary map of values that, when changed, will start a new run",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"wait_for_completion": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Wait for the run to terminate and fail if it did not succeed",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the run",
+			},
+			"exit_code": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The exit code of the run",
+			},
+			"error_message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The error message of the run",
+			},
+			"run_duration": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The duration of the run",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the creation of the run",
+			},
+			"started_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the start of the run",
+			},
+			"terminated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the termination of the run",
+			},
+			"region": regional.Schema(),
+		},
+		CustomizeDiff: cdf.LocalityCheck("job_definition_id"),
+	}
+}
+
+func ResourceJobRunCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, region, err := newAPIWithRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := &jobs.StartJobDefinitionRequest{
+		Region:          region,
+		JobDefinitionID: regional.ExpandID(d.Get("job_definition_id")).ID,
+		Command:         types.ExpandStringPtr(d.Get("command")),
+	}
+
+	if _, ok := d.GetOk("env"); ok {
+		req.EnvironmentVariables = types.ExpandMapPtrStringString(d.Get("env"))
+	}
+
+	res, err := api.StartJobDefinition(req, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(res.JobRuns) == 0 {
+		return diag.Errorf("no run was started for job definition %s", req.JobDefinitionID)
+	}
+
+	run := res.JobRuns[0]
+	d.SetId(regional.NewIDString(region, run.ID))
+
+	if d.Get("wait_for_completion").(bool) {
+		run, err = waitForJobRun(ctx, api, region, run.ID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if run.State != jobs.JobRunStateSucceeded {
+			diags := ResourceJobRunRead(ctx, d, m)
+
+			return append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("job run %s terminated with state %s", run.ID, run.State),
+				Detail:   run.ErrorMessage,
+			})
+		}
+	}
+
+	return ResourceJobRunRead(ctx, d, m)
+}
+
+func ResourceJobRunRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	run, err := api.GetJobRun(&jobs.GetJobRunRequest{
+		JobRunID: id,
+		Region:   region,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if httperrors.Is404(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("job_definition_id", regional.NewIDString(region, run.JobDefinitionID))
+	_ = d.Set("command", run.Command)
+	_ = d.Set("state", run.State.String())
+	_ = d.Set("exit_code", types.FlattenInt32Ptr(run.ExitCode))
+	_ = d.Set("error_message", run.ErrorMessage)
+	_ = d.Set("run_duration", flattenJobRunDuration(run.RunDuration))
+	_ = d.Set("created_at", types.FlattenTime(run.CreatedAt))
+	_ = d.Set("started_at", types.FlattenTime(run.StartedAt))
+	_ = d.Set("terminated_at", types.FlattenTime(run.TerminatedAt))
+	_ = d.Set("region", region)
+
+	return nil
+}
+
+func ResourceJobRunDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	run, err := api.GetJobRun(&jobs.GetJobRunRequest{
+		JobRunID: id,
+		Region:   region,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if httperrors.Is404(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if isJobRunTerminated(run.State) {
+		return nil
+	}
+
+	_, err = api.StopJobRun(&jobs.StopJobRunRequest{
+		JobRunID: id,
+		Region:   region,
+	}, scw.WithContext(ctx))
+	if err != nil && !httperrors.Is404(err) {
+		return diag.FromErr(err)
+	}
+
+	_, err = waitForJobRun(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
+	if err != nil && !httperrors.Is404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
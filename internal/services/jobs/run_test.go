@@ -0,0 +1,48 @@
+package jobs_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccJobRun_Basic(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckJobDefinitionDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource scaleway_job_definition main {
+						name = "test-jobs-job-run-basic"
+						cpu_limit = 120
+						memory_limit = 256
+						image_uri = "docker.io/alpine:latest"
+						command = "echo hello"
+					}
+
+					resource scaleway_job_run main {
+						job_definition_id = scaleway_job_definition.main.id
+						env = {
+							FOO = "bar"
+						}
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrUUID("scaleway_job_run.main", "id"),
+					resource.TestCheckResourceAttrPair("scaleway_job_run.main", "job_definition_id", "scaleway_job_definition.main", "id"),
+					resource.TestCheckResourceAttr("scaleway_job_run.main", "state", "succeeded"),
+					resource.TestCheckResourceAttr("scaleway_job_run.main", "exit_code", "0"),
+					resource.TestCheckResourceAttrSet("scaleway_job_run.main", "terminated_at"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,165 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	jobs "github.com/scaleway/scaleway-sdk-go/api/jobs/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+func DataSourceRuns() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceJobRunsRead,
+		Schema: map[string]*schema.Schema{
+			"job_definition_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only list the runs of this job definition",
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: dsf.Locality,
+			},
+			"state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only list the runs in this state",
+				ValidateDiagFunc: verify.ValidateEnum[jobs.JobRunState](),
+			},
+			"created_after": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only list the runs created after this date (RFC 3339 format)",
+				ValidateDiagFunc: verify.IsDate(),
+			},
+			"created_before": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only list the runs created before this date (RFC 3339 format)",
+				ValidateDiagFunc: verify.IsDate(),
+			},
+			"runs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of runs, ordered by creation date",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"job_definition_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"command": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"env": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"cpu_limit": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"memory_limit": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"exit_code": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"error_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"run_duration": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"started_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"terminated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": regional.ComputedSchema(),
+					},
+				},
+			},
+			"region":          regional.Schema(),
+			"project_id":      account.ProjectIDSchema(),
+			"organization_id": account.OrganizationIDSchema(),
+		},
+	}
+}
+
+func DataSourceJobRunsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, region, err := newAPIWithRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := &jobs.ListJobRunsRequest{
+		Region:         region,
+		OrderBy:        jobs.ListJobRunsRequestOrderByCreatedAtAsc,
+		ProjectID:      types.ExpandStringPtr(d.Get("project_id")),
+		OrganizationID: types.ExpandStringPtr(d.Get("organization_id")),
+	}
+
+	if definitionID, ok := d.GetOk("job_definition_id"); ok {
+		req.JobDefinitionID = types.ExpandStringPtr(regional.ExpandID(definitionID).ID)
+	}
+
+	res, err := api.ListJobRuns(req, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	state := jobs.JobRunState(d.Get("state").(string))
+	createdAfter := types.ExpandTimePtr(d.Get("created_after"))
+	createdBefore := types.ExpandTimePtr(d.Get("created_before"))
+
+	runs := []interface{}(nil)
+	for _, run := range res.JobRuns {
+		if state != "" && run.State != state {
+			continue
+		}
+		if run.CreatedAt != nil {
+			if createdAfter != nil && run.CreatedAt.Before(*createdAfter) {
+				continue
+			}
+			if createdBefore != nil && run.CreatedAt.After(*createdBefore) {
+				continue
+			}
+		}
+
+		runs = append(runs, flattenJobRun(run))
+	}
+
+	d.SetId(region.String())
+	_ = d.Set("runs", runs)
+
+	return nil
+}
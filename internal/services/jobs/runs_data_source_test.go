@@ -0,0 +1,55 @@
+package jobs_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccDataSourceJobRuns_Basic(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckJobDefinitionDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource scaleway_job_definition main {
+						name = "test-jobs-data-source-job-runs"
+						cpu_limit = 120
+						memory_limit = 256
+						image_uri = "docker.io/alpine:latest"
+						command = "echo hello"
+					}
+
+					resource scaleway_job_run main {
+						job_definition_id = scaleway_job_definition.main.id
+					}
+
+					data scaleway_job_runs main {
+						job_definition_id = scaleway_job_definition.main.id
+						depends_on = [scaleway_job_run.main]
+					}
+
+					data scaleway_job_runs failed {
+						job_definition_id = scaleway_job_definition.main.id
+						state = "failed"
+						depends_on = [scaleway_job_run.main]
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_job_runs.main", "runs.#", "1"),
+					resource.TestCheckResourceAttrPair("data.scaleway_job_runs.main", "runs.0.id", "scaleway_job_run.main", "id"),
+					resource.TestCheckResourceAttr("data.scaleway_job_runs.main", "runs.0.state", "succeeded"),
+					resource.TestCheckResourceAttr("data.scaleway_job_runs.failed", "runs.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -18,7 +18,9 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/vpc"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -30,7 +32,7 @@ func ResourceCluster() *schema.Resource {
 		UpdateContext: ResourceK8SClusterUpdate,
 		DeleteContext: ResourceK8SClusterDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: regional.ImportStateByName(findClusterIDByName),
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create:  schema.DefaultTimeout(defaultK8SClusterTimeout),
@@ -224,6 +226,16 @@ func ResourceCluster() *schema.Resource {
 			},
 		},
 		CustomizeDiff: customdiff.All(
+			func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+				priorityExpander := diff.Get("autoscaler_config.0.priority_expander").([]interface{})
+				expander := diff.Get("autoscaler_config.0.expander").(string)
+
+				if len(priorityExpander) > 0 && expander != k8s.AutoscalerExpanderPriority.String() {
+					return fmt.Errorf("autoscaler_config.0.priority_expander can only be set with the %q expander", k8s.AutoscalerExpanderPriority)
+				}
+
+				return nil
+			},
 			func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
 				autoUpgradeEnable, okAutoUpgradeEnable := diff.GetOkExists("auto_upgrade.0.enable")
 
@@ -342,7 +354,7 @@ func ResourceK8SClusterCreate(ctx context.Context, d *schema.ResourceData, m int
 	req := &k8s.CreateClusterRequest{
 		Region:            region,
 		ProjectID:         types.ExpandStringPtr(d.Get("project_id")),
-		Name:              types.ExpandOrGenerateString(d.Get("name"), "cluster"),
+		Name:              meta.ExpandOrGenerateName(m, d.Get("name"), "cluster"),
 		Type:              clusterType.(string),
 		Description:       description.(string),
 		Cni:               k8s.CNI(d.Get("cni").(string)),
@@ -491,7 +503,9 @@ func ResourceK8SClusterCreate(ctx context.Context, d *schema.ResourceData, m int
 
 	// Cluster creation
 
-	res, err := k8sAPI.CreateCluster(req, scw.WithContext(ctx))
+	res, err := vpc.RetryWhenPrivateNetworkNotReady(ctx, d.Timeout(schema.TimeoutCreate), func() (*k8s.Cluster, error) {
+		return k8sAPI.CreateCluster(req, scw.WithContext(ctx))
+	})
 	if err != nil {
 		return append(diag.FromErr(err), diags...)
 	}
@@ -508,6 +522,13 @@ func ResourceK8SClusterCreate(ctx context.Context, d *schema.ResourceData, m int
 		return append(diag.FromErr(err), diags...)
 	}
 
+	if priorityExpander, ok := d.GetOk("autoscaler_config.0.priority_expander"); ok {
+		err = updatePriorityExpanderConfig(ctx, m, k8sAPI, region, res.ID, priorityExpander)
+		if err != nil {
+			return append(diag.FromErr(err), diags...)
+		}
+	}
+
 	return append(ResourceK8SClusterRead(ctx, d, m), diags...)
 }
 
@@ -557,13 +578,34 @@ func ResourceK8SClusterRead(ctx context.Context, d *schema.ResourceData, m inter
 	}
 	_ = d.Set("version", version)
 
+	var diags diag.Diagnostics
+
 	// autoscaler_config
-	_ = d.Set("autoscaler_config", clusterAutoscalerConfigFlatten(cluster))
+	autoscalerConfig := clusterAutoscalerConfigFlatten(cluster)
+	if len(autoscalerConfig) > 0 {
+		// The priority expander configuration lives in the cluster and is not returned by the API.
+		// The ConfigMap is only read when priority_expander is set, it may be managed outside of Terraform otherwise.
+		priorityExpander := d.Get("autoscaler_config.0.priority_expander").([]interface{})
+		if len(priorityExpander) > 0 {
+			actualPriorityExpander, err := readPriorityExpanderConfig(ctx, m, k8sAPI, region, clusterID)
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity:      diag.Warning,
+					Summary:       "Cannot read the priority expander configuration",
+					Detail:        err.Error(),
+					AttributePath: cty.GetAttrPath("autoscaler_config").IndexInt(0).GetAttr("priority_expander"),
+				})
+			} else if expandPriorityExpander(actualPriorityExpander) != expandPriorityExpander(priorityExpander) {
+				// The order of the configuration is kept when the priorities are the same
+				priorityExpander = actualPriorityExpander
+			}
+		}
+		autoscalerConfig[0]["priority_expander"] = priorityExpander
+	}
+	_ = d.Set("autoscaler_config", autoscalerConfig)
 	_ = d.Set("open_id_connect_config", clusterOpenIDConnectConfigFlatten(cluster))
 	_ = d.Set("auto_upgrade", clusterAutoUpgradeFlatten(cluster))
 
-	var diags diag.Diagnostics
-
 	// private_network
 	pnID := types.FlattenStringPtr(cluster.PrivateNetworkID)
 	clusterType := d.Get("type").(string)
@@ -862,6 +904,14 @@ func ResourceK8SClusterUpdate(ctx context.Context, d *schema.ResourceData, m int
 		}
 	}
 
+	// The ConfigMap is only deleted when priority_expander is removed from a configuration which set it
+	if d.HasChange("autoscaler_config.0.priority_expander") {
+		err = updatePriorityExpanderConfig(ctx, m, k8sAPI, region, clusterID, d.Get("autoscaler_config.0.priority_expander"))
+		if err != nil {
+			return append(diag.FromErr(err), diags...)
+		}
+	}
+
 	return append(ResourceK8SClusterRead(ctx, d, m), diags...)
 }
 
@@ -961,6 +1011,30 @@ func autoscalerConfigSchema() *schema.Resource {
 				Default:     600,
 				Description: "Maximum number of seconds the cluster autoscaler waits for pod termination when trying to scale down a node",
 			},
+			"priority_expander": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Priorities of the node groups used by the priority expander, pushed to the cluster as the cluster-autoscaler-priority-expander ConfigMap",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"priority": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The priority of the node groups, the highest priority is preferred",
+						},
+						"pools": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: "Regular expressions matching the names of the node groups having this priority",
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -202,6 +202,48 @@ func TestAccCluster_Autoscaling(t *testing.T) {
 	})
 }
 
+func TestAccCluster_PriorityExpander(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	latestK8SVersion := testAccK8SClusterGetLatestK8SVersion(tt)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+		},
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckK8SClusterDestroy(tt),
+			vpcchecks.CheckPrivateNetworkDestroy(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckK8SClusterConfigPriorityExpander(latestK8SVersion, 10, 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckK8SClusterExists(tt, "scaleway_k8s_cluster.priority"),
+					resource.TestCheckResourceAttr("scaleway_k8s_cluster.priority", "autoscaler_config.0.expander", "priority"),
+					resource.TestCheckResourceAttr("scaleway_k8s_cluster.priority", "autoscaler_config.0.priority_expander.#", "2"),
+					resource.TestCheckResourceAttr("scaleway_k8s_cluster.priority", "autoscaler_config.0.priority_expander.0.priority", "10"),
+					resource.TestCheckResourceAttr("scaleway_k8s_cluster.priority", "autoscaler_config.0.priority_expander.0.pools.0", ".*-default-.*"),
+					resource.TestCheckResourceAttr("scaleway_k8s_cluster.priority", "autoscaler_config.0.priority_expander.1.priority", "50"),
+					resource.TestCheckResourceAttr("scaleway_k8s_cluster.priority", "autoscaler_config.0.priority_expander.1.pools.0", ".*-spot-.*"),
+				),
+			},
+			{
+				Config: testAccCheckK8SClusterConfigPriorityExpander(latestK8SVersion, 50, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckK8SClusterExists(tt, "scaleway_k8s_cluster.priority"),
+					resource.TestCheckResourceAttr("scaleway_k8s_cluster.priority", "autoscaler_config.0.priority_expander.0.priority", "50"),
+					resource.TestCheckResourceAttr("scaleway_k8s_cluster.priority", "autoscaler_config.0.priority_expander.1.priority", "10"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCluster_OIDC(t *testing.T) {
 	tt := acctest.NewTestTools(t)
 	defer tt.Cleanup()
@@ -671,6 +713,41 @@ resource "scaleway_k8s_cluster" "autoscaler" {
 }`, version)
 }
 
+func testAccCheckK8SClusterConfigPriorityExpander(version string, defaultPriority int, spotPriority int) string {
+	return fmt.Sprintf(`
+resource "scaleway_vpc_private_network" "priority" {
+  name       = "test-priority-expander"
+}
+resource "scaleway_k8s_cluster" "priority" {
+	cni = "cilium"
+	version = "%s"
+	name = "test-priority-expander"
+	autoscaler_config {
+		expander = "priority"
+		priority_expander {
+			priority = %d
+			pools = [ ".*-default-.*" ]
+		}
+		priority_expander {
+			priority = %d
+			pools = [ ".*-spot-.*" ]
+		}
+	}
+	tags = [ "terraform-test", "scaleway_k8s_cluster", "priority-expander" ]
+	delete_additional_resources = false
+	private_network_id = scaleway_vpc_private_network.priority.id
+}
+resource "scaleway_k8s_pool" "priority" {
+	cluster_id = scaleway_k8s_cluster.priority.id
+	name = "test-priority-expander"
+	node_type = "PLAY2_MICRO"
+	size = 1
+	autoscaling = true
+	min_size = 1
+	max_size = 2
+}`, version, defaultPriority, spotPriority)
+}
+
 func testAccCheckK8SClusterConfigOIDC(version string) string {
 	return fmt.Sprintf(`
 resource "scaleway_vpc_private_network" "oidc" {
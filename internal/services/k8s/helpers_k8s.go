@@ -1,17 +1,24 @@
 package k8s
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/k8s/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
@@ -19,6 +26,9 @@ const (
 	defaultK8SClusterTimeout = 15 * time.Minute
 	defaultK8SPoolTimeout    = 30 * time.Minute
 	defaultK8SRetryInterval  = 5 * time.Second
+
+	priorityExpanderConfigMapName      = "cluster-autoscaler-priority-expander"
+	priorityExpanderConfigMapNamespace = "kube-system"
 )
 
 func newAPIWithRegion(d *schema.ResourceData, m interface{}) (*k8s.API, scw.Region, error) {
@@ -108,3 +118,276 @@ func getNodes(ctx context.Context, k8sAPI *k8s.API, pool *k8s.Pool) ([]map[strin
 
 	return convertNodes(nodes), nil
 }
+
+// daemonSetList is the subset of a Kubernetes DaemonSetList needed to know if the DaemonSets are ready.
+type daemonSetList struct {
+	Items []struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+		Status struct {
+			DesiredNumberScheduled int `json:"desiredNumberScheduled"`
+			NumberReady            int `json:"numberReady"`
+		} `json:"status"`
+	} `json:"items"`
+}
+
+// kubernetesClient is a minimal client of the Kubernetes API of a cluster, authenticated with its kubeconfig token.
+type kubernetesClient struct {
+	httpClient *http.Client
+	rootCAs    []byte
+	server     string
+	token      string
+}
+
+// newKubernetesClient returns a client of the Kubernetes API described by the kubeconfig.
+// The HTTP client given by the provider configuration is used when there is one so the requests are recorded with the other ones in tests.
+func newKubernetesClient(m interface{}, kubeconfig *k8s.Kubeconfig) (*kubernetesClient, error) {
+	server, err := kubeconfig.GetServer()
+	if err != nil {
+		return nil, err
+	}
+
+	rawCA, err := kubeconfig.GetCertificateAuthorityData()
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := kubeconfig.GetToken()
+	if err != nil {
+		return nil, err
+	}
+
+	ca, err := base64.StdEncoding.DecodeString(rawCA)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode cluster certificate authority: %w", err)
+	}
+
+	rootCAsTransport, err := transport.RootCAsHTTPTransport(ca)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse cluster certificate authority: %w", err)
+	}
+
+	httpClient := &http.Client{
+		Transport: rootCAsTransport,
+	}
+	if meta.ExtractCustomHTTPClient(m) {
+		httpClient = meta.ExtractHTTPClient(m)
+	}
+
+	return &kubernetesClient{
+		httpClient: httpClient,
+		rootCAs:    ca,
+		server:     server,
+		token:      token,
+	}, nil
+}
+
+// do sends a request to the Kubernetes API, body is encoded in JSON if not nil.
+func (c *kubernetesClient) do(ctx context.Context, method string, path string, body interface{}) (*http.Response, error) {
+	var reqBody io.Reader
+
+	if body != nil {
+		rawBody, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reqBody = bytes.NewReader(rawBody)
+	}
+
+	req, err := http.NewRequestWithContext(transport.ContextWithRootCAs(ctx, c.rootCAs), method, c.server+path, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	return c.httpClient.Do(req)
+}
+
+// listSystemDaemonSets lists the DaemonSets of the kube-system namespace using the Kubernetes API of the cluster.
+func listSystemDaemonSets(ctx context.Context, client *kubernetesClient) (*daemonSetList, error) {
+	resp, err := client.do(ctx, http.MethodGet, "/apis/apps/v1/namespaces/kube-system/daemonsets", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to list kube-system DaemonSets: unexpected status %s", resp.Status)
+	}
+
+	daemonSets := &daemonSetList{}
+	err = json.NewDecoder(resp.Body).Decode(daemonSets)
+	if err != nil {
+		return nil, err
+	}
+
+	return daemonSets, nil
+}
+
+// notReadyDaemonSets returns the names of the DaemonSets that are not ready on all their nodes.
+func notReadyDaemonSets(daemonSets *daemonSetList) []string {
+	names := []string(nil)
+	for _, ds := range daemonSets.Items {
+		if ds.Status.NumberReady < ds.Status.DesiredNumberScheduled {
+			names = append(names, ds.Metadata.Name)
+		}
+	}
+
+	return names
+}
+
+// priorityExpanderConfigMap is the subset of a Kubernetes ConfigMap used to configure the priority expander of the cluster autoscaler.
+type priorityExpanderConfigMap struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Metadata   struct {
+		Name      string `json:"name"`
+		Namespace string `json:"namespace"`
+	} `json:"metadata"`
+	Data map[string]string `json:"data"`
+}
+
+// applyPriorityExpanderConfig creates or replaces the ConfigMap read by the cluster autoscaler when the priority expander is used.
+func applyPriorityExpanderConfig(ctx context.Context, client *kubernetesClient, priorities string) error {
+	configMap := &priorityExpanderConfigMap{
+		APIVersion: "v1",
+		Kind:       "ConfigMap",
+		Data: map[string]string{
+			"priorities": priorities,
+		},
+	}
+	configMap.Metadata.Name = priorityExpanderConfigMapName
+	configMap.Metadata.Namespace = priorityExpanderConfigMapNamespace
+
+	collectionPath := "/api/v1/namespaces/" + priorityExpanderConfigMapNamespace + "/configmaps"
+
+	resp, err := client.do(ctx, http.MethodPut, collectionPath+"/"+priorityExpanderConfigMapName, configMap)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp, err = client.do(ctx, http.MethodPost, collectionPath, configMap)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("failed to apply ConfigMap %s/%s: unexpected status %s", priorityExpanderConfigMapNamespace, priorityExpanderConfigMapName, resp.Status)
+	}
+
+	return nil
+}
+
+// deletePriorityExpanderConfig deletes the ConfigMap of the priority expander, a missing ConfigMap is not an error.
+func deletePriorityExpanderConfig(ctx context.Context, client *kubernetesClient) error {
+	resp, err := client.do(ctx, http.MethodDelete, "/api/v1/namespaces/"+priorityExpanderConfigMapNamespace+"/configmaps/"+priorityExpanderConfigMapName, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("failed to delete ConfigMap %s/%s: unexpected status %s", priorityExpanderConfigMapNamespace, priorityExpanderConfigMapName, resp.Status)
+	}
+
+	return nil
+}
+
+// getPriorityExpanderConfig returns the priorities of the ConfigMap of the priority expander, an empty string if the ConfigMap does not exist.
+func getPriorityExpanderConfig(ctx context.Context, client *kubernetesClient) (string, error) {
+	resp, err := client.do(ctx, http.MethodGet, "/api/v1/namespaces/"+priorityExpanderConfigMapNamespace+"/configmaps/"+priorityExpanderConfigMapName, nil)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return "", nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get ConfigMap %s/%s: unexpected status %s", priorityExpanderConfigMapNamespace, priorityExpanderConfigMapName, resp.Status)
+	}
+
+	configMap := &priorityExpanderConfigMap{}
+	err = json.NewDecoder(resp.Body).Decode(configMap)
+	if err != nil {
+		return "", err
+	}
+
+	return configMap.Data["priorities"], nil
+}
+
+// readPriorityExpanderConfig reads the priority_expander configuration of the cluster from its Kubernetes API.
+func readPriorityExpanderConfig(ctx context.Context, m interface{}, k8sAPI *k8s.API, region scw.Region, clusterID string) ([]interface{}, error) {
+	kubeconfig, err := k8sAPI.GetClusterKubeConfig(&k8s.GetClusterKubeConfigRequest{
+		Region:    region,
+		ClusterID: clusterID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newKubernetesClient(m, kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	priorities, err := getPriorityExpanderConfig(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return flattenPriorityExpander(priorities)
+}
+
+// updatePriorityExpanderConfig pushes the priority_expander configuration of the cluster to its Kubernetes API.
+func updatePriorityExpanderConfig(ctx context.Context, m interface{}, k8sAPI *k8s.API, region scw.Region, clusterID string, rawPriorities interface{}) error {
+	kubeconfig, err := k8sAPI.GetClusterKubeConfig(&k8s.GetClusterKubeConfigRequest{
+		Region:    region,
+		ClusterID: clusterID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	client, err := newKubernetesClient(m, kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	priorities := expandPriorityExpander(rawPriorities)
+	if priorities == "" {
+		return deletePriorityExpanderConfig(ctx, client)
+	}
+
+	return applyPriorityExpanderConfig(ctx, client, priorities)
+}
+
+// findClusterIDByName returns the ID of the cluster with the given name, used to import clusters by name
+func findClusterIDByName(ctx context.Context, m interface{}, region scw.Region, name string) (string, error) {
+	res, err := k8s.NewAPI(meta.ExtractScwClient(m)).ListClusters(&k8s.ListClustersRequest{
+		Region: region,
+		Name:   &name,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	cluster, err := datasource.FindExact(res.Clusters, func(c *k8s.Cluster) bool {
+		return c.Name == name
+	}, name)
+	if err != nil {
+		return "", err
+	}
+
+	return cluster.ID, nil
+}
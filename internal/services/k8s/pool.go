@@ -13,6 +13,8 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/vpc"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -104,6 +106,12 @@ func ResourcePool() *schema.Resource {
 				Default:     true,
 				Description: "Whether to wait for the pool to be ready",
 			},
+			"wait_for_system_daemonsets": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to wait for all the nodes of the pool to be ready and for the kube-system DaemonSets to be scheduled on them",
+			},
 			"placement_group_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -239,7 +247,7 @@ func ResourceK8SPoolCreate(ctx context.Context, d *schema.ResourceData, m interf
 	req := &k8s.CreatePoolRequest{
 		Region:           region,
 		ClusterID:        locality.ExpandID(d.Get("cluster_id")),
-		Name:             types.ExpandOrGenerateString(d.Get("name"), "pool"),
+		Name:             meta.ExpandOrGenerateName(m, d.Get("name"), "pool"),
 		NodeType:         d.Get("node_type").(string),
 		Autoscaling:      d.Get("autoscaling").(bool),
 		Autohealing:      d.Get("autohealing").(bool),
@@ -313,7 +321,9 @@ func ResourceK8SPoolCreate(ctx context.Context, d *schema.ResourceData, m interf
 		}
 	}
 
-	res, err := k8sAPI.CreatePool(req, scw.WithContext(ctx))
+	res, err := vpc.RetryWhenPrivateNetworkNotReady(ctx, d.Timeout(schema.TimeoutCreate), func() (*k8s.Pool, error) {
+		return k8sAPI.CreatePool(req, scw.WithContext(ctx))
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -321,10 +331,20 @@ func ResourceK8SPoolCreate(ctx context.Context, d *schema.ResourceData, m interf
 	d.SetId(regional.NewIDString(region, res.ID))
 
 	if d.Get("wait_for_pool_ready").(bool) { // wait for the pool to be ready if specified (including all its nodes)
-		_, err = waitPoolReady(ctx, k8sAPI, region, res.ID, d.Timeout(schema.TimeoutCreate))
+		deadline := time.Now().Add(d.Timeout(schema.TimeoutCreate))
+
+		pool, err := waitPoolReady(ctx, k8sAPI, region, res.ID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return diag.FromErr(err)
 		}
+
+		if d.Get("wait_for_system_daemonsets").(bool) {
+			// The nodes and DaemonSets share the timeout of the operation with the pool
+			err = waitPoolNodesAndDaemonSetsReady(ctx, m, k8sAPI, pool, time.Until(deadline))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 
 	_, err = waitCluster(ctx, k8sAPI, region, cluster.ID, d.Timeout(schema.TimeoutCreate))
@@ -459,10 +479,20 @@ func ResourceK8SPoolUpdate(ctx context.Context, d *schema.ResourceData, m interf
 	}
 
 	if d.Get("wait_for_pool_ready").(bool) { // wait for the pool to be ready if specified (including all its nodes)
-		_, err = waitPoolReady(ctx, k8sAPI, region, res.ID, d.Timeout(schema.TimeoutUpdate))
+		deadline := time.Now().Add(d.Timeout(schema.TimeoutUpdate))
+
+		pool, err := waitPoolReady(ctx, k8sAPI, region, res.ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.FromErr(err)
 		}
+
+		if d.Get("wait_for_system_daemonsets").(bool) {
+			// The nodes and DaemonSets share the timeout of the operation with the pool
+			err = waitPoolNodesAndDaemonSetsReady(ctx, m, k8sAPI, pool, time.Until(deadline))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 
 	return ResourceK8SPoolRead(ctx, d, m)
@@ -420,6 +420,57 @@ func TestAccPool_Size(t *testing.T) {
 	})
 }
 
+func TestAccPool_WaitForSystemDaemonSets(t *testing.T) {
+	if !*acctest.UpdateCassettes {
+		t.Skip("Calls to the Kubernetes API of the cluster are not recorded in cassettes")
+	}
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	latestK8SVersionMinor := testAccK8SClusterGetLatestK8SVersionMinor(tt)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckK8SPoolDestroy(tt, "scaleway_k8s_pool.pool"),
+			testAccCheckK8SClusterDestroy(tt),
+			vpcchecks.CheckPrivateNetworkDestroy(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "scaleway_vpc_private_network" "test-pool-daemonsets" {
+				  name = "test-pool-daemonsets"
+				}
+
+				resource "scaleway_k8s_cluster" "test-pool-daemonsets" {
+				  name                        = "test-pool-daemonsets"
+				  version                     = "%s"
+				  cni                         = "cilium"
+				  delete_additional_resources = false
+				  private_network_id          = scaleway_vpc_private_network.test-pool-daemonsets.id
+				}
+
+				resource "scaleway_k8s_pool" "pool" {
+				  cluster_id                 = scaleway_k8s_cluster.test-pool-daemonsets.id
+				  name                       = "pool"
+				  node_type                  = "pro2_xxs"
+				  size                       = 1
+				  wait_for_pool_ready        = true
+				  wait_for_system_daemonsets = true
+				}`, latestK8SVersionMinor),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckK8SPoolExists(tt, "scaleway_k8s_pool.pool"),
+					resource.TestCheckResourceAttr("scaleway_k8s_pool.pool", "nodes.0.status", k8sSDK.NodeStatusReady.String()),
+					resource.TestCheckResourceAttr("scaleway_k8s_pool.pool", "wait_for_system_daemonsets", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccPool_PublicIPDisabled(t *testing.T) {
 	tt := acctest.NewTestTools(t)
 	defer tt.Cleanup()
@@ -2,11 +2,16 @@ package k8s
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/scaleway/scaleway-sdk-go/api/k8s/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"gopkg.in/yaml.v3"
 )
 
 func clusterAutoscalerConfigFlatten(cluster *k8s.Cluster) []map[string]interface{} {
@@ -116,3 +121,57 @@ func flattenKubeconfig(ctx context.Context, k8sAPI *k8s.API, region scw.Region,
 
 	return kubeconf, nil
 }
+
+// expandPriorityExpander returns the priorities of the priority expander in the YAML format expected by the cluster autoscaler.
+// Pool patterns sharing the same priority are merged.
+func expandPriorityExpander(raw interface{}) string {
+	patternsByPriority := map[int][]string{}
+	for _, rawPriority := range raw.([]interface{}) {
+		priority := rawPriority.(map[string]interface{})
+		value := priority["priority"].(int)
+		patternsByPriority[value] = append(patternsByPriority[value], types.ExpandStrings(priority["pools"])...)
+	}
+
+	priorities := make([]int, 0, len(patternsByPriority))
+	for priority := range patternsByPriority {
+		priorities = append(priorities, priority)
+	}
+	sort.Ints(priorities)
+
+	config := strings.Builder{}
+	for _, priority := range priorities {
+		config.WriteString(strconv.Itoa(priority) + ":\n")
+		for _, pattern := range patternsByPriority[priority] {
+			// A JSON string is a valid YAML double-quoted scalar
+			quotedPattern, _ := json.Marshal(pattern)
+			config.WriteString("  - " + string(quotedPattern) + "\n")
+		}
+	}
+
+	return config.String()
+}
+
+// flattenPriorityExpander parses the priorities of the ConfigMap of the priority expander, sorted by priority
+func flattenPriorityExpander(priorities string) ([]interface{}, error) {
+	patternsByPriority := map[int][]string{}
+	err := yaml.Unmarshal([]byte(priorities), &patternsByPriority)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the priorities of the priority expander: %w", err)
+	}
+
+	values := make([]int, 0, len(patternsByPriority))
+	for priority := range patternsByPriority {
+		values = append(values, priority)
+	}
+	sort.Ints(values)
+
+	flattened := make([]interface{}, 0, len(values))
+	for _, priority := range values {
+		flattened = append(flattened, map[string]interface{}{
+			"priority": priority,
+			"pools":    types.FlattenSliceString(patternsByPriority[priority]),
+		})
+	}
+
+	return flattened, nil
+}
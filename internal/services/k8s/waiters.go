@@ -3,8 +3,10 @@ package k8s
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/scaleway/scaleway-sdk-go/api/k8s/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
@@ -85,3 +87,54 @@ func waitPoolReady(ctx context.Context, k8sAPI *k8s.API, region scw.Region, pool
 	}
 	return pool, nil
 }
+
+// waitPoolNodesAndDaemonSetsReady waits for all the nodes of the pool to be ready
+// and for the DaemonSets of the kube-system namespace to be ready on all the nodes of the cluster.
+func waitPoolNodesAndDaemonSetsReady(ctx context.Context, m interface{}, k8sAPI *k8s.API, pool *k8s.Pool, timeout time.Duration) error {
+	kubeconfig, err := k8sAPI.GetClusterKubeConfig(&k8s.GetClusterKubeConfigRequest{
+		Region:    pool.Region,
+		ClusterID: pool.ClusterID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	// The client is shared by all the attempts
+	client, err := newKubernetesClient(m, kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		nodes, err := k8sAPI.ListNodes(&k8s.ListNodesRequest{
+			Region:    pool.Region,
+			ClusterID: pool.ClusterID,
+			PoolID:    &pool.ID,
+		}, scw.WithAllPages(), scw.WithContext(ctx))
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		readyNodes := uint32(0)
+		for _, node := range nodes.Nodes {
+			if node.Status == k8s.NodeStatusReady {
+				readyNodes++
+			}
+		}
+		if readyNodes < pool.Size {
+			return retry.RetryableError(fmt.Errorf("%d/%d nodes of pool %s are ready", readyNodes, pool.Size, pool.ID))
+		}
+
+		daemonSets, err := listSystemDaemonSets(ctx, client)
+		if err != nil {
+			// The Kubernetes API may not be reachable yet while the cluster is updated.
+			return retry.RetryableError(err)
+		}
+
+		if notReady := notReadyDaemonSets(daemonSets); len(notReady) > 0 {
+			return retry.RetryableError(fmt.Errorf("kube-system DaemonSets are not ready: %s", strings.Join(notReady, ", ")))
+		}
+
+		return nil
+	})
+}
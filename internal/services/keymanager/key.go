@@ -0,0 +1,275 @@
+package keymanager
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	keymanagerSDK "github.com/scaleway/scaleway-sdk-go/api/key_manager/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+func ResourceKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceKeyManagerKeyCreate,
+		ReadContext:   ResourceKeyManagerKeyRead,
+		UpdateContext: ResourceKeyManagerKeyUpdate,
+		DeleteContext: ResourceKeyManagerKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the key",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the key",
+			},
+			"tags": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "The list of tags associated with the key",
+			},
+			"usage": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The usage of the key, which defines the cryptographic operations it can be used for",
+				ValidateFunc: validation.StringInSlice([]string{usageSymmetricEncryption}, false),
+			},
+			"algorithm": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          keymanagerSDK.KeyAlgorithmSymmetricEncryptionAes256Gcm.String(),
+				Description:      "The algorithm of the key",
+				ValidateDiagFunc: verify.ValidateEnum[keymanagerSDK.KeyAlgorithmSymmetricEncryption](),
+			},
+			"rotation_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The rotation policy of the key",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rotation_period": {
+							Type:             schema.TypeString,
+							Required:         true,
+							Description:      "The duration between two key rotations, from 24 hours to 1 year (Go Duration format)",
+							ValidateDiagFunc: verify.IsDuration(),
+							DiffSuppressFunc: dsf.Duration,
+						},
+						"next_rotation_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time of the next rotation of the key (RFC 3339 format)",
+						},
+					},
+				},
+			},
+			"protected": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the key is protected against deletion",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the key",
+			},
+			"origin": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The origin of the key material",
+			},
+			"locked": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the key is locked",
+			},
+			"rotation_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of times the key was rotated",
+			},
+			"rotated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the last rotation of the key (RFC 3339 format)",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the creation of the key (RFC 3339 format)",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the last update of the key (RFC 3339 format)",
+			},
+			"region":     regional.Schema(),
+			"project_id": account.ProjectIDSchema(),
+		},
+	}
+}
+
+func ResourceKeyManagerKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, region, err := newAPIWithRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	usage, err := expandKeyUsage(d.Get("usage").(string), d.Get("algorithm").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rotationPolicy, err := expandKeyRotationPolicy(d.Get("rotation_policy"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	key, err := api.CreateKey(&keymanagerSDK.CreateKeyRequest{
+		Region:         region,
+		ProjectID:      d.Get("project_id").(string),
+		Name:           types.ExpandStringPtr(d.Get("name")),
+		Description:    types.ExpandStringPtr(d.Get("description")),
+		Tags:           types.ExpandStrings(d.Get("tags")),
+		Usage:          usage,
+		RotationPolicy: rotationPolicy,
+		Unprotected:    !d.Get("protected").(bool),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(regional.NewIDString(region, key.ID))
+
+	return ResourceKeyManagerKeyRead(ctx, d, m)
+}
+
+func ResourceKeyManagerKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	key, err := api.GetKey(&keymanagerSDK.GetKeyRequest{
+		Region: region,
+		KeyID:  id,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if httperrors.Is404(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	usage, algorithm := flattenKeyUsage(key.Usage)
+
+	_ = d.Set("name", key.Name)
+	_ = d.Set("description", types.FlattenStringPtr(key.Description))
+	_ = d.Set("tags", types.FlattenSliceString(key.Tags))
+	_ = d.Set("usage", usage)
+	_ = d.Set("algorithm", algorithm)
+	_ = d.Set("rotation_policy", flattenKeyRotationPolicy(key.RotationPolicy))
+	_ = d.Set("protected", key.Protected)
+	_ = d.Set("state", key.State.String())
+	_ = d.Set("origin", key.Origin.String())
+	_ = d.Set("locked", key.Locked)
+	_ = d.Set("rotation_count", int(key.RotationCount))
+	_ = d.Set("rotated_at", types.FlattenTime(key.RotatedAt))
+	_ = d.Set("created_at", types.FlattenTime(key.CreatedAt))
+	_ = d.Set("updated_at", types.FlattenTime(key.UpdatedAt))
+	_ = d.Set("region", key.Region)
+	_ = d.Set("project_id", key.ProjectID)
+
+	return nil
+}
+
+func ResourceKeyManagerKeyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := &keymanagerSDK.UpdateKeyRequest{
+		Region: region,
+		KeyID:  id,
+	}
+
+	hasChanged := false
+
+	if d.HasChange("name") {
+		req.Name = types.ExpandUpdatedStringPtr(d.Get("name"))
+		hasChanged = true
+	}
+
+	if d.HasChange("description") {
+		req.Description = types.ExpandUpdatedStringPtr(d.Get("description"))
+		hasChanged = true
+	}
+
+	if d.HasChange("tags") {
+		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+		hasChanged = true
+	}
+
+	if d.HasChange("rotation_policy") {
+		req.RotationPolicy, err = expandKeyRotationPolicy(d.Get("rotation_policy"))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		hasChanged = true
+	}
+
+	if hasChanged {
+		_, err = api.UpdateKey(req, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("protected") {
+		err = updateKeyProtection(api, region, id, d.Get("protected").(bool), scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ResourceKeyManagerKeyRead(ctx, d, m)
+}
+
+func ResourceKeyManagerKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = api.DeleteKey(&keymanagerSDK.DeleteKeyRequest{
+		Region: region,
+		KeyID:  id,
+	}, scw.WithContext(ctx))
+	if err != nil && !httperrors.Is404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package keymanager_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccDataSourceKey_Basic(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckKeyManagerKeyDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_key_manager_key" "main" {
+						name  = "tf-test-data-source-key"
+						usage = "symmetric_encryption"
+					}
+
+					data "scaleway_key_manager_key" "by_id" {
+						key_id = scaleway_key_manager_key.main.id
+					}
+
+					data "scaleway_key_manager_key" "by_name" {
+						name       = scaleway_key_manager_key.main.name
+						depends_on = [scaleway_key_manager_key.main]
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.scaleway_key_manager_key.by_id", "id", "scaleway_key_manager_key.main", "id"),
+					resource.TestCheckResourceAttrPair("data.scaleway_key_manager_key.by_id", "usage", "scaleway_key_manager_key.main", "usage"),
+					resource.TestCheckResourceAttrPair("data.scaleway_key_manager_key.by_name", "id", "scaleway_key_manager_key.main", "id"),
+					resource.TestCheckResourceAttrPair("data.scaleway_key_manager_key.by_name", "state", "scaleway_key_manager_key.main", "state"),
+				),
+			},
+		},
+	})
+}
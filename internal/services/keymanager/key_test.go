@@ -0,0 +1,138 @@
+package keymanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	keymanagerSDK "github.com/scaleway/scaleway-sdk-go/api/key_manager/v1alpha1"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/keymanager"
+)
+
+func TestAccKey_Basic(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckKeyManagerKeyDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_key_manager_key" "main" {
+						name        = "tf-test-key-basic"
+						description = "tf test key"
+						usage       = "symmetric_encryption"
+						tags        = ["tf", "test"]
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyManagerKeyExists(tt, "scaleway_key_manager_key.main"),
+					resource.TestCheckResourceAttr("scaleway_key_manager_key.main", "name", "tf-test-key-basic"),
+					resource.TestCheckResourceAttr("scaleway_key_manager_key.main", "description", "tf test key"),
+					resource.TestCheckResourceAttr("scaleway_key_manager_key.main", "usage", "symmetric_encryption"),
+					resource.TestCheckResourceAttr("scaleway_key_manager_key.main", "algorithm", "aes_256_gcm"),
+					resource.TestCheckResourceAttr("scaleway_key_manager_key.main", "tags.#", "2"),
+					resource.TestCheckResourceAttr("scaleway_key_manager_key.main", "state", "enabled"),
+					resource.TestCheckResourceAttr("scaleway_key_manager_key.main", "protected", "false"),
+				),
+			},
+			{
+				Config: `
+					resource "scaleway_key_manager_key" "main" {
+						name        = "tf-test-key-basic-updated"
+						usage       = "symmetric_encryption"
+						tags        = ["tf"]
+						protected   = true
+						rotation_policy {
+							rotation_period = "720h"
+						}
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyManagerKeyExists(tt, "scaleway_key_manager_key.main"),
+					resource.TestCheckResourceAttr("scaleway_key_manager_key.main", "name", "tf-test-key-basic-updated"),
+					resource.TestCheckResourceAttr("scaleway_key_manager_key.main", "tags.#", "1"),
+					resource.TestCheckResourceAttr("scaleway_key_manager_key.main", "protected", "true"),
+					resource.TestCheckResourceAttr("scaleway_key_manager_key.main", "rotation_policy.0.rotation_period", "720h0m0s"),
+					resource.TestCheckResourceAttrSet("scaleway_key_manager_key.main", "rotation_policy.0.next_rotation_at"),
+				),
+			},
+			{
+				Config: `
+					resource "scaleway_key_manager_key" "main" {
+						name        = "tf-test-key-basic-updated"
+						usage       = "symmetric_encryption"
+						tags        = ["tf"]
+						protected   = false
+						rotation_policy {
+							rotation_period = "720h"
+						}
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scaleway_key_manager_key.main", "protected", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeyManagerKeyExists(tt *acctest.TestTools, n string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", n)
+		}
+
+		api, region, id, err := keymanager.NewAPIWithRegionAndID(tt.Meta, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = api.GetKey(&keymanagerSDK.GetKeyRequest{
+			KeyID:  id,
+			Region: region,
+		})
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeyManagerKeyDestroy(tt *acctest.TestTools) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for _, rs := range state.RootModule().Resources {
+			if rs.Type != "scaleway_key_manager_key" {
+				continue
+			}
+
+			api, region, id, err := keymanager.NewAPIWithRegionAndID(tt.Meta, rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = api.GetKey(&keymanagerSDK.GetKeyRequest{
+				KeyID:  id,
+				Region: region,
+			})
+			if err == nil {
+				return fmt.Errorf("key (%s) still exists", rs.Primary.ID)
+			}
+
+			if !httperrors.Is404(err) {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
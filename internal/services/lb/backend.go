@@ -368,7 +368,7 @@ func resourceLbBackendCreate(ctx context.Context, d *schema.ResourceData, m inte
 	createReq := &lbSDK.ZonedAPICreateBackendRequest{
 		Zone:                     zone,
 		LBID:                     lbID,
-		Name:                     types.ExpandOrGenerateString(d.Get("name"), "lb-bkd"),
+		Name:                     meta.ExpandOrGenerateName(m, d.Get("name"), "lb-bkd"),
 		ForwardProtocol:          expandLbProtocol(d.Get("forward_protocol")),
 		ForwardPort:              int32(d.Get("forward_port").(int)),
 		ForwardPortAlgorithm:     expandLbForwardPortAlgorithm(d.Get("forward_port_algorithm")),
@@ -15,6 +15,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -265,7 +266,7 @@ func resourceLbFrontendCreate(ctx context.Context, d *schema.ResourceData, m int
 	createFrontendRequest := &lbSDK.ZonedAPICreateFrontendRequest{
 		Zone:          zone,
 		LBID:          lbID,
-		Name:          types.ExpandOrGenerateString(d.Get("name"), "lb-frt"),
+		Name:          meta.ExpandOrGenerateName(m, d.Get("name"), "lb-frt"),
 		InboundPort:   int32(d.Get("inbound_port").(int)),
 		BackendID:     locality.ExpandID(d.Get("backend_id")),
 		TimeoutClient: timeoutClient,
@@ -353,7 +354,7 @@ func flattenLBACLs(acls []*lbSDK.ACL) interface{} {
 	return rawACLs
 }
 
-func resourceLbFrontendUpdateACL(ctx context.Context, d *schema.ResourceData, lbAPI *lbSDK.ZonedAPI, zone scw.Zone, frontendID string) diag.Diagnostics {
+func resourceLbFrontendUpdateACL(ctx context.Context, d *schema.ResourceData, m interface{}, lbAPI *lbSDK.ZonedAPI, zone scw.Zone, frontendID string) diag.Diagnostics {
 	// Fetch existing acl from the api. and convert it to a hashmap with index as key
 	resACL, err := lbAPI.ListACLs(&lbSDK.ZonedAPIListACLsRequest{
 		Zone:       zone,
@@ -402,7 +403,7 @@ func resourceLbFrontendUpdateACL(ctx context.Context, d *schema.ResourceData, lb
 		_, err = lbAPI.CreateACL(&lbSDK.ZonedAPICreateACLRequest{
 			Zone:       zone,
 			FrontendID: frontendID,
-			Name:       types.ExpandOrGenerateString(stateACL.Name, "lb-acl"),
+			Name:       meta.ExpandOrGenerateName(m, stateACL.Name, "lb-acl"),
 			Action:     stateACL.Action,
 			Match:      stateACL.Match,
 			Index:      key,
@@ -461,7 +462,7 @@ func resourceLbFrontendUpdate(ctx context.Context, d *schema.ResourceData, m int
 	req := &lbSDK.ZonedAPIUpdateFrontendRequest{
 		Zone:           zone,
 		FrontendID:     ID,
-		Name:           types.ExpandOrGenerateString(d.Get("name"), "lb-frt"),
+		Name:           meta.ExpandOrGenerateName(m, d.Get("name"), "lb-frt"),
 		InboundPort:    int32(d.Get("inbound_port").(int)),
 		BackendID:      locality.ExpandID(d.Get("backend_id")),
 		TimeoutClient:  timeoutClient,
@@ -474,7 +475,7 @@ func resourceLbFrontendUpdate(ctx context.Context, d *schema.ResourceData, m int
 		return diag.FromErr(err)
 	}
 
-	diagnostics := resourceLbFrontendUpdateACL(ctx, d, lbAPI, zone, ID)
+	diagnostics := resourceLbFrontendUpdateACL(ctx, d, m, lbAPI, zone, ID)
 	if diagnostics != nil {
 		return diagnostics
 	}
@@ -529,6 +529,98 @@ func TestAccFrontend_ACLRedirectAction(t *testing.T) {
 	})
 }
 
+func TestAccFrontend_ACLExclusive(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	config := `
+		resource scaleway_lb_ip ip01 {}
+		resource scaleway_lb lb01 {
+			ip_id = scaleway_lb_ip.ip01.id
+			name = "test-lb-acl-exclusive"
+			type = "lb-s"
+		}
+		resource scaleway_lb_backend bkd01 {
+			lb_id = scaleway_lb.lb01.id
+			forward_protocol = "http"
+			forward_port = 80
+			proxy_protocol = "none"
+		}
+		resource scaleway_lb_frontend frt01 {
+			lb_id = scaleway_lb.lb01.id
+			backend_id = scaleway_lb_backend.bkd01.id
+			name = "tf-test"
+			inbound_port = 80
+			acl {
+				name = "managed"
+				action {
+					type = "allow"
+				}
+				match {
+					ip_subnet = ["192.168.0.1"]
+				}
+			}
+		}
+	`
+	expectedACLs := []*lbSDK.ACL{
+		{
+			Name: "managed",
+			Match: &lbSDK.ACLMatch{
+				IPSubnet:        scw.StringSlicePtr([]string{"192.168.0.1"}),
+				HTTPFilter:      lbSDK.ACLHTTPFilterACLHTTPFilterNone,
+				HTTPFilterValue: []*string{},
+			},
+			Action: &lbSDK.ACLAction{Type: lbSDK.ACLActionTypeAllow},
+		},
+	}
+
+	var frontendID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      isFrontendDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					isACLCorrect(tt, "scaleway_lb_frontend.frt01", expectedACLs),
+					func(s *terraform.State) error {
+						frontendID = s.RootModule().Resources["scaleway_lb_frontend.frt01"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				// An ACL added out of band is deleted on the next apply
+				PreConfig: func() {
+					lbAPI, zone, ID, err := lb.NewAPIWithZoneAndID(tt.Meta, frontendID)
+					if err != nil {
+						t.Fatal(err)
+					}
+					_, err = lbAPI.CreateACL(&lbSDK.ZonedAPICreateACLRequest{
+						Zone:       zone,
+						FrontendID: ID,
+						Name:       "out-of-band",
+						Action:     &lbSDK.ACLAction{Type: lbSDK.ACLActionTypeDeny},
+						Match:      &lbSDK.ACLMatch{IPSubnet: scw.StringSlicePtr([]string{"10.0.0.0/8"})},
+						Index:      2,
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					isACLCorrect(tt, "scaleway_lb_frontend.frt01", expectedACLs),
+				),
+			},
+		},
+	})
+}
+
 func isACLCorrect(tt *acctest.TestTools, frontendName string, expectedAcls []*lbSDK.ACL) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// define a wrapper for acl comparison
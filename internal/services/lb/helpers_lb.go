@@ -12,9 +12,11 @@ import (
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ipamSDK "github.com/scaleway/scaleway-sdk-go/api/ipam/v1"
 	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	validator "github.com/scaleway/scaleway-sdk-go/validation"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
@@ -218,3 +220,51 @@ func customizeDiffAssignFlexibleIPv6(_ context.Context, diff *schema.ResourceDif
 	}
 	return nil
 }
+
+// getLBPrivateNetworkIPs returns the IP addresses assigned by IPAM to a load balancer, indexed by private network ID.
+func getLBPrivateNetworkIPs(ctx context.Context, m interface{}, zone scw.Zone, lbID string) (map[string]string, error) {
+	region, err := zone.Region()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := ipamSDK.NewAPI(meta.ExtractScwClient(m)).ListIPs(&ipamSDK.ListIPsRequest{
+		Region:       region,
+		ResourceID:   &lbID,
+		ResourceType: ipamSDK.ResourceTypeLBServer,
+		IsIPv6:       scw.BoolPtr(false),
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	ips := make(map[string]string, len(res.IPs))
+	for _, ip := range res.IPs {
+		if ip.Source == nil || ip.Source.PrivateNetworkID == nil {
+			continue
+		}
+		ips[*ip.Source.PrivateNetworkID] = ip.Address.IP.String()
+	}
+
+	return ips, nil
+}
+
+// findLbIDByName returns the ID of the load balancer with the given name, used to import load balancers by name
+func findLbIDByName(ctx context.Context, m interface{}, zone scw.Zone, name string) (string, error) {
+	res, err := lbSDK.NewZonedAPI(meta.ExtractScwClient(m)).ListLBs(&lbSDK.ZonedAPIListLBsRequest{
+		Zone: zone,
+		Name: &name,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	lb, err := datasource.FindExact(res.LBs, func(lb *lbSDK.LB) bool {
+		return lb.Name == name
+	}, name)
+	if err != nil {
+		return "", err
+	}
+
+	return lb.ID, nil
+}
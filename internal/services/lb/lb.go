@@ -19,6 +19,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -35,7 +36,7 @@ func ResourceLb() *schema.Resource {
 		UpdateContext: resourceLbUpdate,
 		DeleteContext: resourceLbDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: zonal.ImportStateByName(findLbIDByName),
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create:  schema.DefaultTimeout(defaultLbLbTimeout),
@@ -160,6 +161,11 @@ func ResourceLb() *schema.Resource {
 							Computed:    true,
 							Description: "The status of private network connection",
 						},
+						"ip_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address assigned to the load balancer on this private network",
+						},
 						"zone": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -218,7 +224,7 @@ func resourceLbCreate(ctx context.Context, d *schema.ResourceData, m interface{}
 		IPIDs:                 types.ExpandSliceIDs(d.Get("ip_ids")),
 		IPID:                  types.ExpandStringPtr(locality.ExpandID(d.Get("ip_id"))),
 		ProjectID:             types.ExpandStringPtr(d.Get("project_id")),
-		Name:                  types.ExpandOrGenerateString(d.Get("name"), "lb"),
+		Name:                  meta.ExpandOrGenerateName(m, d.Get("name"), "lb"),
 		Description:           d.Get("description").(string),
 		Type:                  d.Get("type").(string),
 		SslCompatibilityLevel: lbSDK.SSLCompatibilityLevel(*types.ExpandStringPtr(d.Get("ssl_compatibility_level"))),
@@ -324,7 +330,16 @@ func resourceLbRead(ctx context.Context, d *schema.ResourceData, m interface{})
 		}
 		return diag.FromErr(err)
 	}
-	_ = d.Set("private_network", flattenPrivateNetworkConfigs(privateNetworks))
+
+	var privateNetworkIPs map[string]string
+	if len(privateNetworks) > 0 {
+		privateNetworkIPs, err = getLBPrivateNetworkIPs(ctx, m, zone, ID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	_ = d.Set("private_network", flattenPrivateNetworkConfigs(privateNetworks, privateNetworkIPs))
 	return nil
 }
 
@@ -526,6 +526,75 @@ func TestAccLB_WithPrivateNetworksIPAMIDs(t *testing.T) {
 	})
 }
 
+func TestAccLB_WithMultiplePrivateNetworksIPAddress(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			isLbDestroyed(tt),
+			vpcchecks.CheckPrivateNetworkDestroy(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "scaleway_vpc" "vpc01" {
+				  name = "my vpc"
+				}
+
+				resource "scaleway_vpc_private_network" "pn01" {
+				  vpc_id = scaleway_vpc.vpc01.id
+				  ipv4_subnet {
+					subnet = "172.16.32.0/22"
+				  }
+				}
+
+				resource "scaleway_vpc_private_network" "pn02" {
+				  vpc_id = scaleway_vpc.vpc01.id
+				  ipv4_subnet {
+					subnet = "172.16.64.0/22"
+				  }
+				}
+
+				resource "scaleway_ipam_ip" "ip01" {
+				  address = "172.16.32.7"
+				  source {
+					private_network_id = scaleway_vpc_private_network.pn01.id
+				  }
+				}
+
+				resource scaleway_lb lb01 {
+				  name = "test-lb-with-multiple-private-networks"
+				  type = "LB-S"
+
+				  private_network {
+				    private_network_id = scaleway_vpc_private_network.pn01.id
+				    ipam_ids = [scaleway_ipam_ip.ip01.id]
+				  }
+
+				  private_network {
+				    private_network_id = scaleway_vpc_private_network.pn02.id
+				  }
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					isLbPresent(tt, "scaleway_lb.lb01"),
+					resource.TestCheckResourceAttr("scaleway_lb.lb01", "private_network.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("scaleway_lb.lb01", "private_network.*", map[string]string{
+						"ip_address": "172.16.32.7",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(
+						"scaleway_lb.lb01", "private_network.*.private_network_id",
+						"scaleway_vpc_private_network.pn02", "id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLB_WithoutPNConfig(t *testing.T) {
 	tt := acctest.NewTestTools(t)
 	defer tt.Cleanup()
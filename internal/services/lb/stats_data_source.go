@@ -0,0 +1,125 @@
+package lb
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+func DataSourceStats() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceLbStatsRead,
+		Schema: map[string]*schema.Schema{
+			"lb_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The ID of the load-balancer",
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+			},
+			"backend_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only list the stats of the servers of this backend",
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+			},
+			"backend_servers_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of backend servers",
+			},
+			"healthy_backend_servers_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of running backend servers whose last health check passed",
+			},
+			"backend_servers_stats": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The stats of the backend servers",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backend_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"server_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"server_state_changed_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_health_check_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"zone": zonal.Schema(),
+		},
+	}
+}
+
+func DataSourceLbStatsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	lbAPI, zone, err := lbAPIWithZone(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	lbID := locality.ExpandID(d.Get("lb_id"))
+
+	req := &lbSDK.ZonedAPIListBackendStatsRequest{
+		Zone: zone,
+		LBID: lbID,
+	}
+	if backendID, ok := d.GetOk("backend_id"); ok {
+		req.BackendID = types.ExpandStringPtr(locality.ExpandID(backendID))
+	}
+
+	res, err := lbAPI.ListBackendStats(req, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	healthyCount := 0
+	stats := []interface{}(nil)
+	for _, stat := range res.BackendServersStats {
+		if stat.ServerState == lbSDK.BackendServerStatsServerStateRunning &&
+			stat.LastHealthCheckStatus == lbSDK.BackendServerStatsHealthCheckStatusPassed {
+			healthyCount++
+		}
+
+		stats = append(stats, map[string]interface{}{
+			"instance_id":              stat.InstanceID,
+			"backend_id":               zonal.NewIDString(zone, stat.BackendID),
+			"ip":                       stat.IP,
+			"server_state":             stat.ServerState.String(),
+			"server_state_changed_at":  types.FlattenTime(stat.ServerStateChangedAt),
+			"last_health_check_status": stat.LastHealthCheckStatus.String(),
+		})
+	}
+
+	d.SetId(zonal.NewIDString(zone, lbID))
+	_ = d.Set("backend_servers_count", len(res.BackendServersStats))
+	_ = d.Set("healthy_backend_servers_count", healthyCount)
+	_ = d.Set("backend_servers_stats", stats)
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package lb_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccDataSourceStats_Basic(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      isLbDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource scaleway_lb_ip ip01 {}
+					resource scaleway_lb lb01 {
+						ip_id = scaleway_lb_ip.ip01.id
+						name = "test-lb-stats"
+						type = "lb-s"
+					}
+					resource scaleway_lb_backend bkd01 {
+						lb_id = scaleway_lb.lb01.id
+						forward_protocol = "http"
+						forward_port = 80
+						proxy_protocol = "none"
+						server_ips = ["1.1.1.1"]
+					}
+
+					data scaleway_lb_stats main {
+						lb_id = scaleway_lb.lb01.id
+						depends_on = [scaleway_lb_backend.bkd01]
+					}
+
+					data scaleway_lb_stats by_backend {
+						lb_id = scaleway_lb.lb01.id
+						backend_id = scaleway_lb_backend.bkd01.id
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_lb_stats.main", "backend_servers_count", "1"),
+					resource.TestCheckResourceAttr("data.scaleway_lb_stats.main", "backend_servers_stats.0.ip", "1.1.1.1"),
+					resource.TestCheckResourceAttrPair("data.scaleway_lb_stats.by_backend", "backend_servers_stats.0.backend_id", "scaleway_lb_backend.bkd01", "id"),
+				),
+			},
+		},
+	})
+}
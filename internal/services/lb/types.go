@@ -15,10 +15,11 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/vpc"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
-func flattenPrivateNetworkConfigs(privateNetworks []*lb.PrivateNetwork) interface{} {
+func flattenPrivateNetworkConfigs(privateNetworks []*lb.PrivateNetwork, ips map[string]string) interface{} {
 	if len(privateNetworks) == 0 || privateNetworks == nil {
 		return nil
 	}
@@ -41,6 +42,7 @@ func flattenPrivateNetworkConfigs(privateNetworks []*lb.PrivateNetwork) interfac
 			"zone":               pn.LB.Zone.String(),
 			"static_config":      flattenLbPrivateNetworkStaticConfig(pn.StaticConfig), //nolint:staticcheck
 			"ipam_ids":           regional.NewRegionalIDs(pnRegion, pn.IpamIDs),
+			"ip_address":         ips[pn.PrivateNetworkID],
 		})
 	}
 	return pnI
@@ -359,19 +361,24 @@ func attachLBPrivateNetworks(ctx context.Context, lbAPI *lb.ZonedAPI, zone scw.Z
 	var privateNetworks []*lb.PrivateNetwork
 
 	for i := range pnConfigs {
-		pn, err := lbAPI.AttachPrivateNetwork(&lb.ZonedAPIAttachPrivateNetworkRequest{
-			Zone:             zone,
-			LBID:             lbID,
-			PrivateNetworkID: pnConfigs[i].PrivateNetworkID,
-			StaticConfig:     pnConfigs[i].StaticConfig, //nolint:staticcheck
-			DHCPConfig:       pnConfigs[i].DHCPConfig,   //nolint:staticcheck
-			IpamIDs:          pnConfigs[i].IpamIDs,
-		}, scw.WithContext(ctx))
+		pn, err := vpc.RetryWhenPrivateNetworkNotReady(ctx, timeout, func() (*lb.PrivateNetwork, error) {
+			return lbAPI.AttachPrivateNetwork(&lb.ZonedAPIAttachPrivateNetworkRequest{
+				Zone:             zone,
+				LBID:             lbID,
+				PrivateNetworkID: pnConfigs[i].PrivateNetworkID,
+				StaticConfig:     pnConfigs[i].StaticConfig, //nolint:staticcheck
+				DHCPConfig:       pnConfigs[i].DHCPConfig,   //nolint:staticcheck
+				IpamIDs:          pnConfigs[i].IpamIDs,
+			}, scw.WithContext(ctx))
+		})
 		if err != nil && !httperrors.Is404(err) {
 			return nil, err
 		}
+		if pn == nil {
+			tflog.Warn(ctx, fmt.Sprintf("private network %s not found, it is not attached to load balancer %s", pnConfigs[i].PrivateNetworkID, lbID))
+		}
 
-		privateNetworks, err = waitForPrivateNetworks(ctx, lbAPI, zone, pn.LB.ID, timeout)
+		privateNetworks, err = waitForPrivateNetworks(ctx, lbAPI, zone, lbID, timeout)
 		if err != nil && !httperrors.Is404(err) {
 			return nil, err
 		}
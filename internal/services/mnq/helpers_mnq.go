@@ -161,6 +161,10 @@ func ComposeSNSARN(region scw.Region, projectID string, resourceName string) str
 	return composeARN("sns", region, projectID, resourceName)
 }
 
+func ComposeSQSARN(region scw.Region, projectID string, resourceName string) string {
+	return composeARN("sqs", region, projectID, resourceName)
+}
+
 // Set the value inside values at the resource path (e.g. a.0.b sets b's value)
 func setResourceValue(values map[string]interface{}, resourcePath string, value interface{}, resourceSchemas map[string]*schema.Schema) {
 	parts := strings.Split(resourcePath, ".")
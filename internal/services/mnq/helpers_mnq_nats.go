@@ -0,0 +1,98 @@
+package mnq
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nats-io/nats.go"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+const DefaultNATSEndpoint = "nats://nats.mnq.{region}.scaleway.com:4222"
+
+var (
+	natsRetentionPolicies = map[string]nats.RetentionPolicy{
+		"limits":    nats.LimitsPolicy,
+		"interest":  nats.InterestPolicy,
+		"workqueue": nats.WorkQueuePolicy,
+	}
+	natsStorageTypes = map[string]nats.StorageType{
+		"file":   nats.FileStorage,
+		"memory": nats.MemoryStorage,
+	}
+	natsDiscardPolicies = map[string]nats.DiscardPolicy{
+		"old": nats.DiscardOld,
+		"new": nats.DiscardNew,
+	}
+	natsDeliverPolicies = map[string]nats.DeliverPolicy{
+		"all":              nats.DeliverAllPolicy,
+		"last":             nats.DeliverLastPolicy,
+		"new":              nats.DeliverNewPolicy,
+		"last_per_subject": nats.DeliverLastPerSubjectPolicy,
+	}
+	natsAckPolicies = map[string]nats.AckPolicy{
+		"none":     nats.AckNonePolicy,
+		"all":      nats.AckAllPolicy,
+		"explicit": nats.AckExplicitPolicy,
+	}
+)
+
+// natsConnectionSchema returns the attributes used to connect to the NATS account of a JetStream resource.
+func natsConnectionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"endpoint": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     DefaultNATSEndpoint,
+			Description: "The endpoint of the NATS account",
+		},
+		"credentials": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "The content of the credentials file of the NATS account",
+		},
+	}
+}
+
+// natsPolicyNames returns the sorted keys of a mapping between attribute values and NATS policies.
+func natsPolicyNames[T comparable](policies map[string]T) []string {
+	names := make([]string, 0, len(policies))
+	for name := range policies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// flattenNATSPolicy returns the attribute value of a NATS policy.
+func flattenNATSPolicy[T comparable](policies map[string]T, policy T) string {
+	for name, p := range policies {
+		if p == policy {
+			return name
+		}
+	}
+
+	return ""
+}
+
+func composeNATSConsumerID(region scw.Region, streamName string, consumerName string) string {
+	return fmt.Sprintf("%s/%s/%s", region, streamName, consumerName)
+}
+
+func decomposeNATSConsumerID(id string) (region scw.Region, streamName string, consumerName string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("invalid ID format: %q", id)
+	}
+
+	region, err = scw.ParseRegion(parts[0])
+	if err != nil {
+		return "", "", "", err
+	}
+
+	return region, parts[1], parts[2], nil
+}
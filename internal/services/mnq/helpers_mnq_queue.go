@@ -2,10 +2,12 @@ package mnq
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -20,7 +22,6 @@ import (
 	"github.com/nats-io/nats.go"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
-	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
 const (
@@ -68,46 +69,49 @@ func NewSQSClient(httpClient *http.Client, region string, endpoint string, acces
 	return sqs.New(s), nil
 }
 
+// NATSClientWithRegion returns a JetStream client connected with the credentials of the resource.
+// The returned connection must be closed by the caller.
 func NATSClientWithRegion( //nolint:ireturn
 	d *schema.ResourceData,
 	m interface{},
-) (nats.JetStreamContext, scw.Region, error) {
+) (nats.JetStreamContext, *nats.Conn, scw.Region, error) {
 	region, err := meta.ExtractRegion(d, m)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, "", err
 	}
 
 	endpoint := d.Get("endpoint").(string)
 	creds := d.Get("credentials").(string)
-	js, err := newNATSJetStreamClient(region.String(), endpoint, creds)
+	js, nc, err := newNATSJetStreamClient(region.String(), endpoint, creds)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, "", err
 	}
 
-	return js, region, err
+	return js, nc, region, err
 }
 
 func newNATSJetStreamClient( //nolint:ireturn
 	region string,
 	endpoint string,
 	credentials string,
-) (nats.JetStreamContext, error) {
+) (nats.JetStreamContext, *nats.Conn, error) {
 	jwt, seed, err := splitNATSJWTAndSeed(credentials)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	nc, err := nats.Connect(strings.ReplaceAll(endpoint, "{region}", region), nats.UserJWTAndSeed(jwt, seed))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	js, err := nc.JetStream()
 	if err != nil {
-		return nil, err
+		nc.Close()
+		return nil, nil, err
 	}
 
-	return js, nil
+	return js, nc, nil
 }
 
 func splitNATSJWTAndSeed(credentials string) (string, string, error) {
@@ -142,16 +146,69 @@ var SQSAttributesToResourceMap = map[string]string{
 
 // Returns all managed SQS attribute names
 func getSQSAttributeNames() []*string {
-	attributeNames := make([]*string, 0, len(SQSAttributesToResourceMap))
+	attributeNames := make([]*string, 0, len(SQSAttributesToResourceMap)+1)
 
 	for attribute := range SQSAttributesToResourceMap {
 		attributeNames = append(attributeNames, aws.String(attribute))
 	}
 
+	// The redrive policy is a JSON document managed separately
+	attributeNames = append(attributeNames, aws.String(sqs.QueueAttributeNameRedrivePolicy))
+
 	return attributeNames
 }
 
-func resourceMNQQueueName(name interface{}, prefix interface{}, isSQS bool, isSQSFifo bool) string {
+// sqsRedrivePolicy is the JSON document of the RedrivePolicy attribute of a queue
+type sqsRedrivePolicy struct {
+	DeadLetterTargetArn string      `json:"deadLetterTargetArn"`
+	MaxReceiveCount     json.Number `json:"maxReceiveCount"`
+}
+
+// expandSQSRedrivePolicy returns the RedrivePolicy attribute of a queue, an empty string if there is no redrive policy
+func expandSQSRedrivePolicy(raw interface{}) (*string, error) {
+	rawList := raw.([]interface{})
+	if len(rawList) == 0 || rawList[0] == nil {
+		return aws.String(""), nil
+	}
+
+	rawPolicy := rawList[0].(map[string]interface{})
+
+	policy, err := json.Marshal(&sqsRedrivePolicy{
+		DeadLetterTargetArn: rawPolicy["dead_letter_target_arn"].(string),
+		MaxReceiveCount:     json.Number(strconv.Itoa(rawPolicy["max_receive_count"].(int))),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return aws.String(string(policy)), nil
+}
+
+func flattenSQSRedrivePolicy(rawPolicy *string) ([]map[string]interface{}, error) {
+	if rawPolicy == nil || *rawPolicy == "" {
+		return nil, nil
+	}
+
+	policy := &sqsRedrivePolicy{}
+
+	// maxReceiveCount may be returned as a number or as a string, json.Number accepts both
+	err := json.Unmarshal([]byte(*rawPolicy), policy)
+	if err != nil {
+		return nil, err
+	}
+
+	maxReceiveCount, err := policy.MaxReceiveCount.Int64()
+	if err != nil {
+		return nil, err
+	}
+
+	return []map[string]interface{}{{
+		"dead_letter_target_arn": policy.DeadLetterTargetArn,
+		"max_receive_count":      int(maxReceiveCount),
+	}}, nil
+}
+
+func resourceMNQQueueName(m interface{}, name interface{}, prefix interface{}, isSQS bool, isSQSFifo bool) string {
 	if value, ok := name.(string); ok && value != "" {
 		return value
 	}
@@ -160,7 +217,7 @@ func resourceMNQQueueName(name interface{}, prefix interface{}, isSQS bool, isSQ
 	if value, ok := prefix.(string); ok && value != "" {
 		output = id.PrefixedUniqueId(value)
 	} else {
-		output = types.NewRandomName("queue")
+		output = meta.ExpandOrGenerateName(m, "", "queue")
 	}
 	if isSQS && isSQSFifo {
 		return output + SQSFIFOQueueNameSuffix
@@ -169,12 +226,12 @@ func resourceMNQQueueName(name interface{}, prefix interface{}, isSQS bool, isSQ
 	return output
 }
 
-func resourceMNQQueueCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+func resourceMNQQueueCustomizeDiff(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
 	isSQSFifo := d.Get("fifo_queue").(bool)
 
 	var name string
 	if d.Id() == "" {
-		name = resourceMNQQueueName(d.Get("name"), d.Get("name_prefix"), true, isSQSFifo)
+		name = resourceMNQQueueName(m, d.Get("name"), d.Get("name_prefix"), true, isSQSFifo)
 	} else {
 		name = d.Get("name").(string)
 	}
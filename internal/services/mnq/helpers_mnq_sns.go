@@ -17,7 +17,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
-	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
 func SNSClientWithRegion(d *schema.ResourceData, m interface{}) (*sns.SNS, scw.Region, error) {
@@ -114,7 +113,7 @@ var (
 	}
 )
 
-func resourceMNQSNSTopicName(name interface{}, prefix interface{}, isSQS bool, isSQSFifo bool) string {
+func resourceMNQSNSTopicName(m interface{}, name interface{}, prefix interface{}, isSQS bool, isSQSFifo bool) string {
 	if value, ok := name.(string); ok && value != "" {
 		return value
 	}
@@ -123,7 +122,7 @@ func resourceMNQSNSTopicName(name interface{}, prefix interface{}, isSQS bool, i
 	if value, ok := prefix.(string); ok && value != "" {
 		output = id.PrefixedUniqueId(value)
 	} else {
-		output = types.NewRandomName("topic")
+		output = meta.ExpandOrGenerateName(m, "", "topic")
 	}
 	if isSQS && isSQSFifo {
 		return output + SQSFIFOQueueNameSuffix
@@ -132,12 +131,12 @@ func resourceMNQSNSTopicName(name interface{}, prefix interface{}, isSQS bool, i
 	return output
 }
 
-func resourceMNQSSNSTopicCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+func resourceMNQSSNSTopicCustomizeDiff(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
 	isFifoTopic := d.Get("fifo_topic").(bool)
 
 	var name string
 	if d.Id() == "" {
-		name = resourceMNQSNSTopicName(d.Get("name"), d.Get("name_prefix"), true, isFifoTopic)
+		name = resourceMNQSNSTopicName(m, d.Get("name"), d.Get("name_prefix"), true, isFifoTopic)
 	} else {
 		name = d.Get("name").(string)
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
@@ -50,7 +51,7 @@ func ResourceMNQNatsAccountCreate(ctx context.Context, d *schema.ResourceData, m
 	account, err := api.CreateNatsAccount(&mnq.NatsAPICreateNatsAccountRequest{
 		Region:    region,
 		ProjectID: d.Get("project_id").(string),
-		Name:      types.ExpandOrGenerateString(d.Get("name").(string), "nats-account"),
+		Name:      meta.ExpandOrGenerateName(m, d.Get("name").(string), "nats-account"),
 	}, scw.WithContext(ctx))
 	if err != nil {
 		return diag.FromErr(err)
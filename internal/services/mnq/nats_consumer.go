@@ -0,0 +1,198 @@
+package mnq
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nats-io/nats.go"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+)
+
+func ResourceNatsConsumer() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"stream_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The name of the stream of the consumer",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The durable name of the consumer",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The description of the consumer",
+		},
+		"filter_subject": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Only deliver the messages of the stream matching this subject",
+		},
+		"deliver_policy": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "all",
+			ValidateFunc: validation.StringInSlice(natsPolicyNames(natsDeliverPolicies), false),
+			Description:  "The point of the stream from which the consumer starts receiving messages",
+		},
+		"ack_policy": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "explicit",
+			ValidateFunc: validation.StringInSlice(natsPolicyNames(natsAckPolicies), false),
+			Description:  "How the messages must be acknowledged",
+		},
+		"ack_wait": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      30,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "The number of seconds to wait for the acknowledgement of a message before redelivering it",
+		},
+		"max_deliver": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Default:     -1,
+			Description: "The maximum number of delivery attempts of a message, -1 for unlimited",
+		},
+		"max_ack_pending": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Default:     1000,
+			Description: "The maximum number of messages delivered without acknowledgement, -1 for unlimited",
+		},
+		"region": regional.Schema(),
+	}
+
+	for key, value := range natsConnectionSchema() {
+		s[key] = value
+	}
+
+	return &schema.Resource{
+		CreateContext: ResourceMNQNatsConsumerCreate,
+		ReadContext:   ResourceMNQNatsConsumerRead,
+		UpdateContext: ResourceMNQNatsConsumerUpdate,
+		DeleteContext: ResourceMNQNatsConsumerDelete,
+		SchemaVersion: 0,
+		Schema:        s,
+	}
+}
+
+func ResourceMNQNatsConsumerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	js, nc, region, err := NATSClientWithRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer nc.Close()
+
+	streamName := d.Get("stream_name").(string)
+
+	consumer, err := js.AddConsumer(streamName, expandNATSConsumerConfig(d), nats.Context(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(composeNATSConsumerID(region, streamName, consumer.Name))
+
+	return ResourceMNQNatsConsumerRead(ctx, d, m)
+}
+
+func ResourceMNQNatsConsumerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	js, nc, _, err := NATSClientWithRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer nc.Close()
+
+	region, streamName, consumerName, err := decomposeNATSConsumerID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	consumer, err := js.ConsumerInfo(streamName, consumerName, nats.Context(ctx))
+	if err != nil {
+		if errors.Is(err, nats.ErrConsumerNotFound) || errors.Is(err, nats.ErrStreamNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("stream_name", consumer.Stream)
+	_ = d.Set("name", consumer.Name)
+	_ = d.Set("description", consumer.Config.Description)
+	_ = d.Set("filter_subject", consumer.Config.FilterSubject)
+	_ = d.Set("deliver_policy", flattenNATSPolicy(natsDeliverPolicies, consumer.Config.DeliverPolicy))
+	_ = d.Set("ack_policy", flattenNATSPolicy(natsAckPolicies, consumer.Config.AckPolicy))
+	_ = d.Set("ack_wait", int(consumer.Config.AckWait.Seconds()))
+	_ = d.Set("max_deliver", consumer.Config.MaxDeliver)
+	_ = d.Set("max_ack_pending", consumer.Config.MaxAckPending)
+	_ = d.Set("region", region)
+
+	return nil
+}
+
+func ResourceMNQNatsConsumerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	js, nc, _, err := NATSClientWithRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer nc.Close()
+
+	_, streamName, _, err := decomposeNATSConsumerID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("description", "filter_subject", "ack_wait", "max_deliver", "max_ack_pending") {
+		_, err = js.UpdateConsumer(streamName, expandNATSConsumerConfig(d), nats.Context(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ResourceMNQNatsConsumerRead(ctx, d, m)
+}
+
+func ResourceMNQNatsConsumerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	js, nc, _, err := NATSClientWithRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer nc.Close()
+
+	_, streamName, consumerName, err := decomposeNATSConsumerID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = js.DeleteConsumer(streamName, consumerName, nats.Context(ctx))
+	if err != nil && !errors.Is(err, nats.ErrConsumerNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func expandNATSConsumerConfig(d *schema.ResourceData) *nats.ConsumerConfig {
+	return &nats.ConsumerConfig{
+		Durable:       d.Get("name").(string),
+		Description:   d.Get("description").(string),
+		FilterSubject: d.Get("filter_subject").(string),
+		DeliverPolicy: natsDeliverPolicies[d.Get("deliver_policy").(string)],
+		AckPolicy:     natsAckPolicies[d.Get("ack_policy").(string)],
+		AckWait:       time.Duration(d.Get("ack_wait").(int)) * time.Second,
+		MaxDeliver:    d.Get("max_deliver").(int),
+		MaxAckPending: d.Get("max_ack_pending").(int),
+	}
+}
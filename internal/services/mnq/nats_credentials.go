@@ -11,7 +11,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
-	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 )
 
 func ResourceNatsCredentials() *schema.Resource {
@@ -58,7 +58,7 @@ func ResourceMNQNatsCredentialsCreate(ctx context.Context, d *schema.ResourceDat
 	credentials, err := api.CreateNatsCredentials(&mnq.NatsAPICreateNatsCredentialsRequest{
 		Region:        region,
 		NatsAccountID: locality.ExpandID(d.Get("account_id").(string)),
-		Name:          types.ExpandOrGenerateString(d.Get("name").(string), "nats-credentials"),
+		Name:          meta.ExpandOrGenerateName(m, d.Get("name").(string), "nats-credentials"),
 	}, scw.WithContext(ctx))
 	if err != nil {
 		return diag.FromErr(err)
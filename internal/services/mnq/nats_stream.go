@@ -0,0 +1,205 @@
+package mnq
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nats-io/nats.go"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+)
+
+func ResourceNatsStream() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The name of the stream",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The description of the stream",
+		},
+		"subjects": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The subjects the stream is listening on, defaults to the name of the stream",
+		},
+		"retention_policy": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "limits",
+			ValidateFunc: validation.StringInSlice(natsPolicyNames(natsRetentionPolicies), false),
+			Description:  "The retention policy of the messages of the stream",
+		},
+		"storage": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "file",
+			ValidateFunc: validation.StringInSlice(natsPolicyNames(natsStorageTypes), false),
+			Description:  "The storage backend of the stream",
+		},
+		"discard_policy": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "old",
+			ValidateFunc: validation.StringInSlice(natsPolicyNames(natsDiscardPolicies), false),
+			Description:  "The policy applied to the messages when the limits of the stream are reached",
+		},
+		"max_messages": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Default:     -1,
+			Description: "The maximum number of messages stored by the stream, -1 for unlimited",
+		},
+		"max_bytes": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Default:     -1,
+			Description: "The maximum size of the messages stored by the stream in bytes, -1 for unlimited",
+		},
+		"max_age": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "The maximum age of the messages of the stream in seconds, 0 for unlimited",
+		},
+		"replicas": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 5),
+			Description:  "The number of replicas of the stream",
+		},
+		"region": regional.Schema(),
+	}
+
+	for key, value := range natsConnectionSchema() {
+		s[key] = value
+	}
+
+	return &schema.Resource{
+		CreateContext: ResourceMNQNatsStreamCreate,
+		ReadContext:   ResourceMNQNatsStreamRead,
+		UpdateContext: ResourceMNQNatsStreamUpdate,
+		DeleteContext: ResourceMNQNatsStreamDelete,
+		SchemaVersion: 0,
+		Schema:        s,
+	}
+}
+
+func ResourceMNQNatsStreamCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	js, nc, region, err := NATSClientWithRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer nc.Close()
+
+	stream, err := js.AddStream(expandNATSStreamConfig(d), nats.Context(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(regional.NewIDString(region, stream.Config.Name))
+
+	return ResourceMNQNatsStreamRead(ctx, d, m)
+}
+
+func ResourceMNQNatsStreamRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	js, nc, _, err := NATSClientWithRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer nc.Close()
+
+	region, name, err := regional.ParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	stream, err := js.StreamInfo(name, nats.Context(ctx))
+	if err != nil {
+		if errors.Is(err, nats.ErrStreamNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("name", stream.Config.Name)
+	_ = d.Set("description", stream.Config.Description)
+	_ = d.Set("subjects", stream.Config.Subjects)
+	_ = d.Set("retention_policy", flattenNATSPolicy(natsRetentionPolicies, stream.Config.Retention))
+	_ = d.Set("storage", flattenNATSPolicy(natsStorageTypes, stream.Config.Storage))
+	_ = d.Set("discard_policy", flattenNATSPolicy(natsDiscardPolicies, stream.Config.Discard))
+	_ = d.Set("max_messages", stream.Config.MaxMsgs)
+	_ = d.Set("max_bytes", stream.Config.MaxBytes)
+	_ = d.Set("max_age", int(stream.Config.MaxAge.Seconds()))
+	_ = d.Set("replicas", stream.Config.Replicas)
+	_ = d.Set("region", region)
+
+	return nil
+}
+
+func ResourceMNQNatsStreamUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	js, nc, _, err := NATSClientWithRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer nc.Close()
+
+	if d.HasChanges("description", "subjects", "discard_policy", "max_messages", "max_bytes", "max_age", "replicas") {
+		_, err = js.UpdateStream(expandNATSStreamConfig(d), nats.Context(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ResourceMNQNatsStreamRead(ctx, d, m)
+}
+
+func ResourceMNQNatsStreamDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	js, nc, _, err := NATSClientWithRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer nc.Close()
+
+	_, name, err := regional.ParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = js.DeleteStream(name, nats.Context(ctx))
+	if err != nil && !errors.Is(err, nats.ErrStreamNotFound) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func expandNATSStreamConfig(d *schema.ResourceData) *nats.StreamConfig {
+	return &nats.StreamConfig{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Subjects:    types.ExpandStrings(d.Get("subjects")),
+		Retention:   natsRetentionPolicies[d.Get("retention_policy").(string)],
+		Storage:     natsStorageTypes[d.Get("storage").(string)],
+		Discard:     natsDiscardPolicies[d.Get("discard_policy").(string)],
+		MaxMsgs:     int64(d.Get("max_messages").(int)),
+		MaxBytes:    int64(d.Get("max_bytes").(int)),
+		MaxAge:      time.Duration(d.Get("max_age").(int)) * time.Second,
+		Replicas:    d.Get("replicas").(int),
+	}
+}
@@ -0,0 +1,102 @@
+package mnq_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccNatsStream_Basic(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      isNatsAccountDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource scaleway_account_project main {
+						name = "tf_tests_mnq_nats_stream_basic"
+					}
+
+					resource scaleway_mnq_nats_account main {
+						project_id = scaleway_account_project.main.id
+						name = "test-mnq-nats-stream-basic"
+					}
+
+					resource scaleway_mnq_nats_credentials main {
+						account_id = scaleway_mnq_nats_account.main.id
+					}
+
+					resource scaleway_mnq_nats_stream main {
+						endpoint    = scaleway_mnq_nats_account.main.endpoint
+						credentials = scaleway_mnq_nats_credentials.main.file
+						name        = "orders"
+						subjects    = ["orders.>"]
+						max_age     = 3600
+					}
+
+					resource scaleway_mnq_nats_consumer main {
+						endpoint       = scaleway_mnq_nats_account.main.endpoint
+						credentials    = scaleway_mnq_nats_credentials.main.file
+						stream_name    = scaleway_mnq_nats_stream.main.name
+						name           = "billing"
+						filter_subject = "orders.created"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scaleway_mnq_nats_stream.main", "name", "orders"),
+					resource.TestCheckResourceAttr("scaleway_mnq_nats_stream.main", "subjects.0", "orders.>"),
+					resource.TestCheckResourceAttr("scaleway_mnq_nats_stream.main", "retention_policy", "limits"),
+					resource.TestCheckResourceAttr("scaleway_mnq_nats_stream.main", "max_age", "3600"),
+					resource.TestCheckResourceAttr("scaleway_mnq_nats_consumer.main", "name", "billing"),
+					resource.TestCheckResourceAttr("scaleway_mnq_nats_consumer.main", "ack_policy", "explicit"),
+				),
+			},
+			{
+				Config: `
+					resource scaleway_account_project main {
+						name = "tf_tests_mnq_nats_stream_basic"
+					}
+
+					resource scaleway_mnq_nats_account main {
+						project_id = scaleway_account_project.main.id
+						name = "test-mnq-nats-stream-basic"
+					}
+
+					resource scaleway_mnq_nats_credentials main {
+						account_id = scaleway_mnq_nats_account.main.id
+					}
+
+					resource scaleway_mnq_nats_stream main {
+						endpoint     = scaleway_mnq_nats_account.main.endpoint
+						credentials  = scaleway_mnq_nats_credentials.main.file
+						name         = "orders"
+						subjects     = ["orders.>"]
+						max_age      = 7200
+						max_messages = 1000
+					}
+
+					resource scaleway_mnq_nats_consumer main {
+						endpoint       = scaleway_mnq_nats_account.main.endpoint
+						credentials    = scaleway_mnq_nats_credentials.main.file
+						stream_name    = scaleway_mnq_nats_stream.main.name
+						name           = "billing"
+						filter_subject = "orders.created"
+						max_deliver    = 5
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scaleway_mnq_nats_stream.main", "max_age", "7200"),
+					resource.TestCheckResourceAttr("scaleway_mnq_nats_stream.main", "max_messages", "1000"),
+					resource.TestCheckResourceAttr("scaleway_mnq_nats_consumer.main", "max_deliver", "5"),
+				),
+			},
+		},
+	})
+}
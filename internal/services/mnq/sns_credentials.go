@@ -9,6 +9,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
@@ -87,7 +88,7 @@ func ResourceMNQSNSCredentialsCreate(ctx context.Context, d *schema.ResourceData
 	credentials, err := api.CreateSnsCredentials(&mnq.SnsAPICreateSnsCredentialsRequest{
 		Region:    region,
 		ProjectID: d.Get("project_id").(string),
-		Name:      types.ExpandOrGenerateString(d.Get("name").(string), "sns-credentials"),
+		Name:      meta.ExpandOrGenerateName(m, d.Get("name").(string), "sns-credentials"),
 		Permissions: &mnq.SnsPermissions{
 			CanPublish: types.ExpandBoolPtr(d.Get("permissions.0.can_publish")),
 			CanReceive: types.ExpandBoolPtr(d.Get("permissions.0.can_receive")),
@@ -118,7 +118,7 @@ func ResourceMNQSNSTopicCreate(ctx context.Context, d *schema.ResourceData, m in
 	}
 
 	isFifo := d.Get("fifo_topic").(bool)
-	topicName := resourceMNQSNSTopicName(d.Get("name"), d.Get("name_prefix"), true, isFifo)
+	topicName := resourceMNQSNSTopicName(m, d.Get("name"), d.Get("name_prefix"), true, isFifo)
 
 	input := &sns.CreateTopicInput{
 		Name:       scw.StringPtr(topicName),
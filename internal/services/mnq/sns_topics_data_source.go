@@ -0,0 +1,128 @@
+package mnq
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+)
+
+func DataSourceSNSTopics() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceMNQSNSTopicsRead,
+		Schema: map[string]*schema.Schema{
+			"name_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the topics with a name starting with this prefix",
+			},
+			"sns_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "https://sns.mnq.{region}.scaleway.com",
+				Description: "SNS endpoint",
+			},
+			"access_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "SNS access key",
+			},
+			"secret_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "SNS secret key",
+			},
+			"topics": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of topics",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fifo_topic": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"region":     regional.Schema(),
+			"project_id": account.ProjectIDSchema(),
+		},
+	}
+}
+
+func DataSourceMNQSNSTopicsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	snsClient, region, err := SNSClientWithRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectID, _, err := meta.ExtractProjectID(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namePrefix := d.Get("name_prefix").(string)
+	topics := []interface{}(nil)
+
+	var parseErr error
+
+	err = snsClient.ListTopicsPagesWithContext(ctx, &sns.ListTopicsInput{}, func(page *sns.ListTopicsOutput, _ bool) bool {
+		for _, topic := range page.Topics {
+			arn, err := decomposeARN(aws.StringValue(topic.TopicArn))
+			if err != nil {
+				parseErr = err
+
+				return false
+			}
+
+			if !strings.HasPrefix(arn.ResourceName, namePrefix) {
+				continue
+			}
+
+			topics = append(topics, map[string]interface{}{
+				"id":         composeMNQID(region, arn.ProjectID, arn.ResourceName),
+				"name":       arn.ResourceName,
+				"arn":        aws.StringValue(topic.TopicArn),
+				"fifo_topic": strings.HasSuffix(arn.ResourceName, SQSFIFOQueueNameSuffix),
+			})
+		}
+
+		return true
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if parseErr != nil {
+		return diag.FromErr(parseErr)
+	}
+
+	d.SetId(datasource.NewRegionalID(projectID, region))
+	_ = d.Set("topics", topics)
+	_ = d.Set("region", region)
+	_ = d.Set("project_id", projectID)
+
+	return nil
+}
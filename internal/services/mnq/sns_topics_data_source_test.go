@@ -0,0 +1,70 @@
+package mnq_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccDataSourceSNSTopics_Basic(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      isSNSTopicDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource scaleway_account_project main {
+						name = "tf_tests_ds_mnq_sns_topics_basic"
+					}
+
+					resource scaleway_mnq_sns main {
+						project_id = scaleway_account_project.main.id
+					}
+
+					resource scaleway_mnq_sns_credentials main {
+						project_id = scaleway_mnq_sns.main.project_id
+						permissions {
+							can_manage = true
+						}
+					}
+
+					resource scaleway_mnq_sns_topic billing {
+						project_id = scaleway_mnq_sns.main.project_id
+						name = "team-billing-topic"
+						access_key = scaleway_mnq_sns_credentials.main.access_key
+						secret_key = scaleway_mnq_sns_credentials.main.secret_key
+					}
+
+					resource scaleway_mnq_sns_topic other {
+						project_id = scaleway_mnq_sns.main.project_id
+						name = "team-other-topic"
+						access_key = scaleway_mnq_sns_credentials.main.access_key
+						secret_key = scaleway_mnq_sns_credentials.main.secret_key
+					}
+
+					data scaleway_mnq_sns_topics billing {
+						project_id = scaleway_mnq_sns.main.project_id
+						name_prefix = "team-billing"
+						access_key = scaleway_mnq_sns_credentials.main.access_key
+						secret_key = scaleway_mnq_sns_credentials.main.secret_key
+
+						depends_on = [scaleway_mnq_sns_topic.billing, scaleway_mnq_sns_topic.other]
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_mnq_sns_topics.billing", "topics.#", "1"),
+					resource.TestCheckResourceAttr("data.scaleway_mnq_sns_topics.billing", "topics.0.name", "team-billing-topic"),
+					resource.TestCheckResourceAttrPair("data.scaleway_mnq_sns_topics.billing", "topics.0.id", "scaleway_mnq_sns_topic.billing", "id"),
+					resource.TestCheckResourceAttrPair("data.scaleway_mnq_sns_topics.billing", "topics.0.arn", "scaleway_mnq_sns_topic.billing", "arn"),
+				),
+			},
+		},
+	})
+}
@@ -108,6 +108,27 @@ func ResourceSQSQueue() *schema.Resource {
 				ValidateFunc: validation.IntBetween(1024, 262_144),
 				Description:  "The maximum size of a message. Should be in bytes.",
 			},
+			"redrive_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The dead-letter queue of the queue",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dead_letter_target_arn": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ARN of the dead-letter queue, must be of the same type (standard or FIFO) as the queue",
+						},
+						"max_receive_count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 1000),
+							Description:  "The number of times a message is received before being moved to the dead-letter queue",
+						},
+					},
+				},
+			},
 			"region":     regional.Schema(),
 			"project_id": account.ProjectIDSchema(),
 
@@ -118,6 +139,11 @@ func ResourceSQSQueue() *schema.Resource {
 				Computed:    true,
 				Description: "The URL of the queue",
 			},
+			"arn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ARN of the queue",
+			},
 		},
 		CustomizeDiff: resourceMNQQueueCustomizeDiff,
 		StateUpgraders: []schema.StateUpgrader{
@@ -159,13 +185,20 @@ func ResourceMNQSQSQueueCreate(ctx context.Context, d *schema.ResourceData, m in
 	}
 
 	isFifo := d.Get("fifo_queue").(bool)
-	queueName := resourceMNQQueueName(d.Get("name"), d.Get("name_prefix"), true, isFifo)
+	queueName := resourceMNQQueueName(m, d.Get("name"), d.Get("name_prefix"), true, isFifo)
 
 	attributes, err := awsResourceDataToAttributes(d, ResourceSQSQueue().Schema, SQSAttributesToResourceMap)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if redrivePolicy, ok := d.GetOk("redrive_policy"); ok {
+		attributes[sqs.QueueAttributeNameRedrivePolicy], err = expandSQSRedrivePolicy(redrivePolicy)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	input := &sqs.CreateQueueInput{
 		Attributes: attributes,
 		QueueName:  scw.StringPtr(queueName),
@@ -228,6 +261,13 @@ func ResourceMNQSQSQueueRead(ctx context.Context, d *schema.ResourceData, m inte
 	_ = d.Set("region", region)
 	_ = d.Set("project_id", projectID)
 	_ = d.Set("url", types.FlattenStringPtr(queue.QueueUrl))
+	_ = d.Set("arn", ComposeSQSARN(region, projectID, queueName))
+
+	redrivePolicy, err := flattenSQSRedrivePolicy(queueAttributes.Attributes[sqs.QueueAttributeNameRedrivePolicy])
+	if err != nil {
+		return diag.Errorf("failed to read SQS Queue redrive policy: %s", err)
+	}
+	_ = d.Set("redrive_policy", redrivePolicy)
 
 	for k, v := range values {
 		_ = d.Set(k, v) // lintignore: R001
@@ -265,6 +305,14 @@ func ResourceMNQSQSQueueUpdate(ctx context.Context, d *schema.ResourceData, m in
 		return diag.FromErr(err)
 	}
 
+	if d.HasChange("redrive_policy") {
+		// An empty redrive policy removes the dead-letter queue
+		attributes[sqs.QueueAttributeNameRedrivePolicy], err = expandSQSRedrivePolicy(d.Get("redrive_policy"))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	_, err = sqsClient.SetQueueAttributesWithContext(ctx, &sqs.SetQueueAttributesInput{
 		QueueUrl:   queue.QueueUrl,
 		Attributes: attributes,
@@ -0,0 +1,44 @@
+package mnq
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+)
+
+func DataSourceSQSQueue() *schema.Resource {
+	// Generate datasource schema from resource
+	dsSchema := datasource.SchemaFromResourceSchema(ResourceSQSQueue().Schema)
+	delete(dsSchema, "name_prefix")
+
+	datasource.FixDatasourceSchemaFlags(dsSchema, true, "name", "access_key", "secret_key")
+	datasource.AddOptionalFieldsToSchema(dsSchema, "sqs_endpoint", "region", "project_id")
+
+	dsSchema["access_key"].Sensitive = true
+	dsSchema["secret_key"].Sensitive = true
+	dsSchema["sqs_endpoint"].Default = ResourceSQSQueue().Schema["sqs_endpoint"].Default
+
+	return &schema.Resource{
+		ReadContext: DataSourceMNQSQSQueueRead,
+		Schema:      dsSchema,
+	}
+}
+
+func DataSourceMNQSQSQueueRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	region, err := meta.ExtractRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectID, _, err := meta.ExtractProjectID(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(composeMNQID(region, projectID, d.Get("name").(string)))
+
+	return ResourceMNQSQSQueueRead(ctx, d, m)
+}
@@ -169,6 +169,93 @@ func TestAccSQSQueue_DefaultProject(t *testing.T) {
 	})
 }
 
+func TestAccSQSQueue_RedrivePolicy(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	config := func(redrivePolicy string) string {
+		return fmt.Sprintf(`
+			resource scaleway_account_project main {
+				name = "tf_tests_mnq_sqs_queue_redrive_policy"
+			}
+
+			resource scaleway_mnq_sqs main {
+				project_id = scaleway_account_project.main.id
+			}
+
+			resource scaleway_mnq_sqs_credentials main {
+				project_id = scaleway_mnq_sqs.main.project_id
+				permissions {
+					can_manage = true
+				}
+			}
+
+			resource scaleway_mnq_sqs_queue dlq {
+				project_id = scaleway_mnq_sqs.main.project_id
+				name = "test-mnq-sqs-queue-dlq.fifo"
+				fifo_queue = true
+				sqs_endpoint = scaleway_mnq_sqs.main.endpoint
+				access_key = scaleway_mnq_sqs_credentials.main.access_key
+				secret_key = scaleway_mnq_sqs_credentials.main.secret_key
+			}
+
+			resource scaleway_mnq_sqs_queue main {
+				project_id = scaleway_mnq_sqs.main.project_id
+				name = "test-mnq-sqs-queue-redrive.fifo"
+				fifo_queue = true
+				content_based_deduplication = true
+				sqs_endpoint = scaleway_mnq_sqs.main.endpoint
+				access_key = scaleway_mnq_sqs_credentials.main.access_key
+				secret_key = scaleway_mnq_sqs_credentials.main.secret_key
+				%s
+			}
+
+			data scaleway_mnq_sqs_queue main {
+				project_id = scaleway_mnq_sqs_queue.main.project_id
+				name = scaleway_mnq_sqs_queue.main.name
+				sqs_endpoint = scaleway_mnq_sqs.main.endpoint
+				access_key = scaleway_mnq_sqs_credentials.main.access_key
+				secret_key = scaleway_mnq_sqs_credentials.main.secret_key
+			}
+		`, redrivePolicy)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      isSQSQueueDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: config(`
+					redrive_policy {
+						dead_letter_target_arn = scaleway_mnq_sqs_queue.dlq.arn
+						max_receive_count = 3
+					}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					isSQSQueuePresent(tt, "scaleway_mnq_sqs_queue.main"),
+					resource.TestCheckResourceAttrPair("scaleway_mnq_sqs_queue.main", "redrive_policy.0.dead_letter_target_arn", "scaleway_mnq_sqs_queue.dlq", "arn"),
+					resource.TestCheckResourceAttr("scaleway_mnq_sqs_queue.main", "redrive_policy.0.max_receive_count", "3"),
+					resource.TestCheckResourceAttr("scaleway_mnq_sqs_queue.main", "content_based_deduplication", "true"),
+					resource.TestCheckResourceAttrPair("data.scaleway_mnq_sqs_queue.main", "arn", "scaleway_mnq_sqs_queue.main", "arn"),
+					resource.TestCheckResourceAttrPair("data.scaleway_mnq_sqs_queue.main", "url", "scaleway_mnq_sqs_queue.main", "url"),
+					resource.TestCheckResourceAttr("data.scaleway_mnq_sqs_queue.main", "fifo_queue", "true"),
+					resource.TestCheckResourceAttr("data.scaleway_mnq_sqs_queue.main", "redrive_policy.0.max_receive_count", "3"),
+				),
+			},
+			{
+				Config: config(""),
+				Check: resource.ComposeTestCheckFunc(
+					isSQSQueuePresent(tt, "scaleway_mnq_sqs_queue.main"),
+					resource.TestCheckResourceAttr("scaleway_mnq_sqs_queue.main", "redrive_policy.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func isSQSQueuePresent(tt *acctest.TestTools, n string) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		rs, ok := state.RootModule().Resources[n]
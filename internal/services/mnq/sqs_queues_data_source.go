@@ -0,0 +1,118 @@
+package mnq
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+)
+
+func DataSourceSQSQueues() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceMNQSQSQueuesRead,
+		Schema: map[string]*schema.Schema{
+			"name_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the queues with a name starting with this prefix",
+			},
+			"sqs_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "https://sqs.mnq.{region}.scaleway.com",
+				Description: "The sqs endpoint",
+			},
+			"access_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "SQS access key",
+			},
+			"secret_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "SQS secret key",
+			},
+			"queues": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of queues",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fifo_queue": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"region":     regional.Schema(),
+			"project_id": account.ProjectIDSchema(),
+		},
+	}
+}
+
+func DataSourceMNQSQSQueuesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sqsClient, region, err := SQSClientWithRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectID, _, err := meta.ExtractProjectID(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &sqs.ListQueuesInput{}
+	if namePrefix, ok := d.GetOk("name_prefix"); ok {
+		input.QueueNamePrefix = types.ExpandStringPtr(namePrefix)
+	}
+
+	queues := []interface{}(nil)
+
+	err = sqsClient.ListQueuesPagesWithContext(ctx, input, func(page *sqs.ListQueuesOutput, _ bool) bool {
+		for _, queueURL := range page.QueueUrls {
+			name := path.Base(aws.StringValue(queueURL))
+			queues = append(queues, map[string]interface{}{
+				"id":         composeMNQID(region, projectID, name),
+				"name":       name,
+				"url":        aws.StringValue(queueURL),
+				"fifo_queue": strings.HasSuffix(name, SQSFIFOQueueNameSuffix),
+			})
+		}
+
+		return true
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(datasource.NewRegionalID(projectID, region))
+	_ = d.Set("queues", queues)
+	_ = d.Set("region", region)
+	_ = d.Set("project_id", projectID)
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package mnq_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccDataSourceSQSQueues_Basic(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      isSQSQueueDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource scaleway_account_project main {
+						name = "tf_tests_ds_mnq_sqs_queues_basic"
+					}
+
+					resource scaleway_mnq_sqs main {
+						project_id = scaleway_account_project.main.id
+					}
+
+					resource scaleway_mnq_sqs_credentials main {
+						project_id = scaleway_mnq_sqs.main.project_id
+						permissions {
+							can_manage = true
+						}
+					}
+
+					resource scaleway_mnq_sqs_queue billing {
+						project_id = scaleway_mnq_sqs.main.project_id
+						name = "team-billing-queue"
+						sqs_endpoint = scaleway_mnq_sqs.main.endpoint
+						access_key = scaleway_mnq_sqs_credentials.main.access_key
+						secret_key = scaleway_mnq_sqs_credentials.main.secret_key
+					}
+
+					resource scaleway_mnq_sqs_queue other {
+						project_id = scaleway_mnq_sqs.main.project_id
+						name = "team-other-queue"
+						sqs_endpoint = scaleway_mnq_sqs.main.endpoint
+						access_key = scaleway_mnq_sqs_credentials.main.access_key
+						secret_key = scaleway_mnq_sqs_credentials.main.secret_key
+					}
+
+					data scaleway_mnq_sqs_queues billing {
+						project_id = scaleway_mnq_sqs.main.project_id
+						name_prefix = "team-billing"
+						sqs_endpoint = scaleway_mnq_sqs.main.endpoint
+						access_key = scaleway_mnq_sqs_credentials.main.access_key
+						secret_key = scaleway_mnq_sqs_credentials.main.secret_key
+
+						depends_on = [scaleway_mnq_sqs_queue.billing, scaleway_mnq_sqs_queue.other]
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_mnq_sqs_queues.billing", "queues.#", "1"),
+					resource.TestCheckResourceAttr("data.scaleway_mnq_sqs_queues.billing", "queues.0.name", "team-billing-queue"),
+					resource.TestCheckResourceAttrPair("data.scaleway_mnq_sqs_queues.billing", "queues.0.id", "scaleway_mnq_sqs_queue.billing", "id"),
+					resource.TestCheckResourceAttrPair("data.scaleway_mnq_sqs_queues.billing", "queues.0.url", "scaleway_mnq_sqs_queue.billing", "url"),
+				),
+			},
+		},
+	})
+}
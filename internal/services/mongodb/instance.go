@@ -12,8 +12,10 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
@@ -177,10 +179,14 @@ func ResourceInstanceCreate(ctx context.Context, d *schema.ResourceData, m inter
 		volume := &mongodb.RestoreSnapshotRequestVolumeDetails{
 			VolumeType: mongodb.VolumeType(d.Get("volume_type").(string)),
 		}
-		id := regional.ExpandID(snapshotID.(string))
+		region, err := zone.Region()
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		restoreSnapshotRequest := &mongodb.RestoreSnapshotRequest{
-			SnapshotID:   id.ID,
-			InstanceName: types.ExpandOrGenerateString(d.Get("name"), "mongodb"),
+			Region:       region,
+			SnapshotID:   locality.ExpandID(snapshotID),
+			InstanceName: meta.ExpandOrGenerateName(m, d.Get("name"), "mongodb"),
 			NodeNumber:   *nodeNumber,
 			NodeType:     d.Get("node_type").(string),
 			Volume:       volume,
@@ -189,10 +195,27 @@ func ResourceInstanceCreate(ctx context.Context, d *schema.ResourceData, m inter
 		if err != nil {
 			return diag.FromErr(err)
 		}
+
+		// Tags cannot be set when restoring a snapshot
+		if tags, tagsExist := d.GetOk("tags"); tagsExist {
+			_, err = waitForInstance(ctx, mongodbAPI, res.Region, res.ID, d.Timeout(schema.TimeoutCreate))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			_, err = mongodbAPI.UpdateInstance(&mongodb.UpdateInstanceRequest{
+				Region:     res.Region,
+				InstanceID: res.ID,
+				Tags:       types.ExpandStringsPtr(tags),
+			}, scw.WithContext(ctx))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	} else {
 		createReq := &mongodb.CreateInstanceRequest{
 			ProjectID:  d.Get("project_id").(string),
-			Name:       types.ExpandOrGenerateString(d.Get("name"), "mongodb"),
+			Name:       meta.ExpandOrGenerateName(m, d.Get("name"), "mongodb"),
 			Version:    d.Get("version").(string),
 			NodeType:   d.Get("node_type").(string),
 			NodeNumber: *nodeNumber,
@@ -196,6 +196,54 @@ func TestAccMongoDBInstance_FromSnapshot(t *testing.T) {
 	})
 }
 
+func TestAccMongoDBInstance_FromSnapshotWithTags(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      IsInstanceDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_mongodb_instance" "main" {
+						name        = "test-mongodb-from-snapshot-tags"
+						version     = "7.0.12"
+						node_type   = "MGDB-PLAY2-NANO"
+						node_number = 1
+						user_name   = "my_initial_user"
+						password    = "thiZ_is_v&ry_s3cret"
+					}
+
+					resource "scaleway_mongodb_snapshot" "main" {
+						instance_id = scaleway_mongodb_instance.main.id
+						name        = "test-snapshot-tags"
+						expires_at  = timeadd(timestamp(), "24h")
+						lifecycle {
+							ignore_changes = [expires_at]
+						}
+					}
+
+					resource "scaleway_mongodb_instance" "restored" {
+						snapshot_id = scaleway_mongodb_snapshot.main.id
+						name        = "restored-mongodb-from-snapshot-tags"
+						node_type   = "MGDB-PLAY2-NANO"
+						node_number = 1
+						tags        = ["terraform-test", "restored"]
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					isMongoDBInstancePresent(tt, "scaleway_mongodb_instance.restored"),
+					resource.TestCheckResourceAttr("scaleway_mongodb_instance.restored", "tags.#", "2"),
+					resource.TestCheckResourceAttr("scaleway_mongodb_instance.restored", "tags.1", "restored"),
+				),
+			},
+		},
+	})
+}
+
 func isMongoDBInstancePresent(tt *acctest.TestTools, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
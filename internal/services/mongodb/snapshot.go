@@ -11,6 +11,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -94,7 +95,7 @@ func ResourceSnapshotCreate(ctx context.Context, d *schema.ResourceData, m inter
 	createReq := &mongodb.CreateSnapshotRequest{
 		InstanceID: instanceID,
 		Region:     region,
-		Name:       types.ExpandOrGenerateString(d.Get("name"), "snapshot"),
+		Name:       meta.ExpandOrGenerateName(m, d.Get("name"), "snapshot"),
 		ExpiresAt:  types.ExpandTimePtr(d.Get("expires_at")),
 	}
 
@@ -160,7 +161,7 @@ func ResourceSnapshotUpdate(ctx context.Context, d *schema.ResourceData, m inter
 	hasChanged := false
 
 	if d.HasChange("name") {
-		newName := types.ExpandOrGenerateString(d.Get("name"), "snapshot")
+		newName := meta.ExpandOrGenerateName(m, d.Get("name"), "snapshot")
 		updateReq.Name = &newName
 		hasChanged = true
 	}
@@ -20,6 +20,18 @@ func DataSourceBucket() *schema.Resource {
 	// Set 'Optional' schema elements
 	datasource.AddOptionalFieldsToSchema(dsSchema, "name", "region", "project_id")
 
+	dsSchema["allow_missing"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Do not fail if the bucket does not exist, exists is set to false instead",
+	}
+	dsSchema["exists"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Whether the bucket exists",
+	}
+
 	return &schema.Resource{
 		ReadContext: DataSourceObjectStorageRead,
 		Schema:      dsSchema,
@@ -51,6 +63,14 @@ func DataSourceObjectStorageRead(ctx context.Context, d *schema.ResourceData, m
 	log.Printf("[DEBUG] Reading Object Storage bucket: %s", input)
 	_, err = s3Client.HeadBucketWithContext(ctx, input)
 	if err != nil {
+		if d.Get("allow_missing").(bool) && isS3NotFound(err) {
+			d.SetId(regional.NewIDString(region, bucket))
+			_ = d.Set("exists", false)
+			_ = d.Set("region", region)
+
+			return nil
+		}
+
 		return diag.FromErr(fmt.Errorf("failed getting Object Storage bucket (%s): %w", bucket, err))
 	}
 
@@ -64,5 +84,6 @@ func DataSourceObjectStorageRead(ctx context.Context, d *schema.ResourceData, m
 
 	bucketRegionalID := regional.NewIDString(region, bucket)
 	d.SetId(bucketRegionalID)
+	_ = d.Set("exists", true)
 	return resourceObjectBucketRead(ctx, d, m)
 }
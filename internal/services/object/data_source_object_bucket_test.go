@@ -179,3 +179,58 @@ func TestAccDataSourceObjectBucket_ProjectIDForbidden(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDataSourceObjectBucket_AllowMissing(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	bucketName := sdkacctest.RandomWithPrefix("tf-tests-scaleway-object-bucket-missing")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      objectchecks.IsBucketDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "scaleway_object_bucket" "missing" {
+						name = "%[1]s"
+						region = "%[2]s"
+					}
+				`, bucketName, objectTestsMainRegion),
+				ExpectError: regexp.MustCompile("failed getting Object Storage bucket"),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "scaleway_object_bucket" "missing" {
+						name = "%[1]s"
+						region = "%[2]s"
+						allow_missing = true
+					}
+				`, bucketName, objectTestsMainRegion),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_object_bucket.missing", "exists", "false"),
+					resource.TestCheckResourceAttr("data.scaleway_object_bucket.missing", "id", objectTestsMainRegion+"/"+bucketName),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_object_bucket" "main" {
+						name = "%[1]s"
+						region = "%[2]s"
+					}
+
+					data "scaleway_object_bucket" "existing" {
+						name = scaleway_object_bucket.main.id
+						allow_missing = true
+					}
+				`, bucketName, objectTestsMainRegion),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_object_bucket.existing", "exists", "true"),
+					resource.TestCheckResourceAttr("data.scaleway_object_bucket.existing", "name", bucketName),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,88 @@
+package object
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+)
+
+func DataSourceEndpoint() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceObjectEndpointRead,
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the bucket to build the endpoints of",
+			},
+			"api_endpoint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The S3 API endpoint of the region",
+			},
+			"api_hostname": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The hostname of the S3 API endpoint of the region",
+			},
+			"bucket_endpoint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The virtual-hosted-style endpoint of the bucket",
+			},
+			"bucket_hostname": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The virtual-hosted-style hostname of the bucket",
+			},
+			"bucket_path_style_endpoint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The path-style endpoint of the bucket",
+			},
+			"website_endpoint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The website endpoint of the bucket",
+			},
+			"website_domain": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The website domain of the region",
+			},
+			"region": regional.Schema(),
+		},
+	}
+}
+
+func DataSourceObjectEndpointRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	region, err := meta.ExtractRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	apiEndpoint := objectBucketAPIEndpointURL(region)
+	websiteDomain := WebsiteDomainURL(region.String())
+
+	_ = d.Set("api_endpoint", apiEndpoint)
+	_ = d.Set("api_hostname", fmt.Sprintf("s3.%s.scw.cloud", region))
+	_ = d.Set("website_domain", websiteDomain)
+	_ = d.Set("region", region.String())
+
+	bucketName := d.Get("bucket").(string)
+	if bucketName != "" {
+		_ = d.Set("bucket_endpoint", objectBucketEndpointURL(bucketName, region))
+		_ = d.Set("bucket_hostname", fmt.Sprintf("%s.s3.%s.scw.cloud", bucketName, region))
+		_ = d.Set("bucket_path_style_endpoint", apiEndpoint+"/"+bucketName)
+		_ = d.Set("website_endpoint", fmt.Sprintf("%s.%s", bucketName, websiteDomain))
+		d.SetId(regional.NewIDString(region, bucketName))
+	} else {
+		d.SetId(region.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package object_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccDataSourceObjectEndpoint_Basic(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "scaleway_object_endpoint" "region" {
+						region = "` + objectTestsMainRegion + `"
+					}
+
+					data "scaleway_object_endpoint" "bucket" {
+						bucket = "test-acc-object-endpoint"
+						region = "` + objectTestsSecondaryRegion + `"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_object_endpoint.region", "api_endpoint", "https://s3.nl-ams.scw.cloud"),
+					resource.TestCheckResourceAttr("data.scaleway_object_endpoint.region", "api_hostname", "s3.nl-ams.scw.cloud"),
+					resource.TestCheckResourceAttr("data.scaleway_object_endpoint.region", "website_domain", "s3-website.nl-ams.scw.cloud"),
+					resource.TestCheckNoResourceAttr("data.scaleway_object_endpoint.region", "bucket_endpoint"),
+					resource.TestCheckResourceAttr("data.scaleway_object_endpoint.bucket", "bucket_endpoint", "https://test-acc-object-endpoint.s3.pl-waw.scw.cloud"),
+					resource.TestCheckResourceAttr("data.scaleway_object_endpoint.bucket", "bucket_hostname", "test-acc-object-endpoint.s3.pl-waw.scw.cloud"),
+					resource.TestCheckResourceAttr("data.scaleway_object_endpoint.bucket", "bucket_path_style_endpoint", "https://s3.pl-waw.scw.cloud/test-acc-object-endpoint"),
+					resource.TestCheckResourceAttr("data.scaleway_object_endpoint.bucket", "website_endpoint", "test-acc-object-endpoint.s3-website.pl-waw.scw.cloud"),
+				),
+			},
+		},
+	})
+}
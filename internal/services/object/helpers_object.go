@@ -239,6 +239,12 @@ func IsS3Err(err error, code string, message string) bool {
 	return false
 }
 
+// isS3NotFound returns true if the error is a missing bucket error.
+// HEAD requests have no body, so a missing bucket is reported with the NotFound code instead of NoSuchBucket.
+func isS3NotFound(err error) bool {
+	return IsS3Err(err, s3.ErrCodeNoSuchBucket, "") || IsS3Err(err, "NotFound", "")
+}
+
 func flattenObjectBucketVersioning(versioningResponse *s3.GetBucketVersioningOutput) []map[string]interface{} {
 	vcl := []map[string]interface{}{{}}
 	vcl[0]["enabled"] = versioningResponse.Status != nil && *versioningResponse.Status == s3.BucketVersioningStatusEnabled
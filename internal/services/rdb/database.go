@@ -7,7 +7,6 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
@@ -15,6 +14,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/cdf"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
 
@@ -100,20 +100,9 @@ func ResourceRdbDatabaseCreate(ctx context.Context, d *schema.ResourceData, m in
 		Name:       d.Get("name").(string),
 	}
 
-	var db *rdb.Database
-	//  wrapper around StateChangeConf that will just retry the database creation
-	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
-		currentDB, errCreateDB := rdbAPI.CreateDatabase(createReq, scw.WithContext(ctx))
-		if errCreateDB != nil {
-			// WIP: Issue on creation/write database. Need a database stable status
-			if httperrors.Is409(errCreateDB) {
-				return retry.RetryableError(errCreateDB)
-			}
-			return retry.NonRetryableError(errCreateDB)
-		}
-		// set database information
-		db = currentDB
-		return nil
+	// The instance may be busy applying a previous change
+	db, err := transport.RetryOnTransientError(ctx, d.Timeout(schema.TimeoutCreate), func() (*rdb.Database, error) {
+		return rdbAPI.CreateDatabase(createReq, scw.WithContext(ctx))
 	})
 	if err != nil {
 		return diag.FromErr(err)
@@ -12,6 +12,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -98,7 +99,7 @@ func ResourceRdbDatabaseBackupCreate(ctx context.Context, d *schema.ResourceData
 		Region:       region,
 		InstanceID:   locality.ExpandID(instanceID),
 		DatabaseName: d.Get("database_name").(string),
-		Name:         types.ExpandOrGenerateString(d.Get("name"), "backup"),
+		Name:         meta.ExpandOrGenerateName(m, d.Get("name"), "backup"),
 		ExpiresAt:    types.ExpandTimePtr(d.Get("expires_at")),
 	}
 
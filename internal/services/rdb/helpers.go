@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
@@ -100,3 +101,23 @@ func getIPConfigUpdate(d *schema.ResourceData, ipFieldName string) (ipamConfig *
 	}
 	return ipamConfig, staticConfig
 }
+
+// findInstanceIDByName returns the ID of the database instance with the given name, used to import instances by name
+func findInstanceIDByName(ctx context.Context, m interface{}, region scw.Region, name string) (string, error) {
+	res, err := newAPI(m).ListInstances(&rdb.ListInstancesRequest{
+		Region: region,
+		Name:   &name,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	instance, err := datasource.FindExact(res.Instances, func(i *rdb.Instance) bool {
+		return i.Name == name
+	}, name)
+	if err != nil {
+		return "", err
+	}
+
+	return instance.ID, nil
+}
@@ -17,7 +17,9 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/vpc"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -36,7 +38,7 @@ func ResourceInstance() *schema.Resource {
 			Default: schema.DefaultTimeout(defaultInstanceTimeout),
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: regional.ImportStateByName(findInstanceIDByName),
 		},
 		SchemaVersion: 0,
 		Schema: map[string]*schema.Schema{
@@ -328,7 +330,7 @@ func ResourceRdbInstanceCreate(ctx context.Context, d *schema.ResourceData, m in
 	createReq := &rdb.CreateInstanceRequest{
 		Region:        region,
 		ProjectID:     types.ExpandStringPtr(d.Get("project_id")),
-		Name:          types.ExpandOrGenerateString(d.Get("name"), "rdb"),
+		Name:          meta.ExpandOrGenerateName(m, d.Get("name"), "rdb"),
 		NodeType:      d.Get("node_type").(string),
 		Engine:        d.Get("engine").(string),
 		IsHaCluster:   d.Get("is_ha_cluster").(bool),
@@ -373,7 +375,9 @@ func ResourceRdbInstanceCreate(ctx context.Context, d *schema.ResourceData, m in
 		createReq.VolumeSize = scw.Size(uint64(size.(int)) * uint64(scw.GB))
 	}
 
-	res, err := rdbAPI.CreateInstance(createReq, scw.WithContext(ctx))
+	res, err := vpc.RetryWhenPrivateNetworkNotReady(ctx, d.Timeout(schema.TimeoutCreate), func() (*rdb.Instance, error) {
+		return rdbAPI.CreateInstance(createReq, scw.WithContext(ctx))
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -804,9 +808,11 @@ func ResourceRdbInstanceUpdate(ctx context.Context, d *schema.ResourceData, m in
 				tflog.Warn(ctx, warning.Detail)
 			}
 			for _, e := range privateEndpoints {
-				_, err := rdbAPI.CreateEndpoint(
-					&rdb.CreateEndpointRequest{Region: region, InstanceID: ID, EndpointSpec: e},
-					scw.WithContext(ctx))
+				_, err := vpc.RetryWhenPrivateNetworkNotReady(ctx, d.Timeout(schema.TimeoutUpdate), func() (*rdb.Endpoint, error) {
+					return rdbAPI.CreateEndpoint(
+						&rdb.CreateEndpointRequest{Region: region, InstanceID: ID, EndpointSpec: e},
+						scw.WithContext(ctx))
+				})
 				if err != nil {
 					return diag.FromErr(err)
 				}
@@ -148,6 +148,11 @@ func ResourceReadReplica() *schema.Resource {
 					},
 				},
 			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the read replica",
+			},
 			// Common
 			"region": regional.Schema(),
 		},
@@ -218,6 +223,7 @@ func ResourceRdbReadReplicaRead(ctx context.Context, d *schema.ResourceData, m i
 
 	regionStr := region.String()
 	_ = d.Set("same_zone", rr.SameZone)
+	_ = d.Set("status", rr.Status.String())
 	_ = d.Set("region", regionStr)
 	_ = d.Set("instance_id", regional.NewIDString(region, rr.InstanceID))
 
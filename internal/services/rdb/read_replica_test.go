@@ -51,6 +51,7 @@ func TestAccReadReplica_Basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("scaleway_rdb_read_replica.replica", "direct_access.0.ip"),
 					resource.TestCheckResourceAttrSet("scaleway_rdb_read_replica.replica", "direct_access.0.port"),
 					resource.TestCheckResourceAttrSet("scaleway_rdb_read_replica.replica", "direct_access.0.endpoint_id"),
+					resource.TestCheckResourceAttr("scaleway_rdb_read_replica.replica", "status", "ready"),
 				),
 			},
 		},
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,7 +20,9 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/vpc"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -216,6 +219,7 @@ func ResourceCluster() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			cdf.LocalityCheck("private_network.#.id"),
 			customizeDiffMigrateClusterSize(),
+			customizeDiffMigrateVersion(),
 		),
 	}
 }
@@ -233,6 +237,46 @@ func customizeDiffMigrateClusterSize() schema.CustomizeDiffFunc {
 	}
 }
 
+// customizeDiffMigrateVersion checks that a version change can be applied in place.
+// Redis clusters can only be upgraded to an available version, a downgrade is rejected to avoid replacing the cluster and losing its data.
+func customizeDiffMigrateVersion() schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+		if diff.Id() == "" || !diff.HasChange("version") || !diff.NewValueKnown("version") {
+			return nil
+		}
+
+		oldRaw, newRaw := diff.GetChange("version")
+		oldVersion, err := version.NewVersion(oldRaw.(string))
+		if err != nil {
+			return err
+		}
+		newVersion, err := version.NewVersion(newRaw.(string))
+		if err != nil {
+			return err
+		}
+
+		if newVersion.LessThan(oldVersion) {
+			return fmt.Errorf("redis cluster cannot be downgraded from version %s to %s, create a new cluster to use an older version", oldRaw, newRaw)
+		}
+
+		res, err := newAPI(m).ListClusterVersions(&redis.ListClusterVersionsRequest{
+			Zone:    scw.Zone(diff.Get("zone").(string)),
+			Version: types.ExpandStringPtr(newRaw),
+		}, scw.WithContext(ctx), scw.WithAllPages())
+		if err != nil {
+			return err
+		}
+
+		for _, v := range res.Versions {
+			if v.Version == newRaw.(string) {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("redis version %s is not available, the cluster cannot be upgraded from %s", newRaw, oldRaw)
+	}
+}
+
 func ResourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	redisAPI, zone, err := newAPIWithZone(d, m)
 	if err != nil {
@@ -242,7 +286,7 @@ func ResourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interf
 	createReq := &redis.CreateClusterRequest{
 		Zone:      zone,
 		ProjectID: d.Get("project_id").(string),
-		Name:      types.ExpandOrGenerateString(d.Get("name"), "redis"),
+		Name:      meta.ExpandOrGenerateName(m, d.Get("name"), "redis"),
 		Version:   d.Get("version").(string),
 		NodeType:  d.Get("node_type").(string),
 		UserName:  d.Get("user_name").(string),
@@ -283,7 +327,9 @@ func ResourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interf
 		createReq.Endpoints = pnSpecs
 	}
 
-	res, err := redisAPI.CreateCluster(createReq, scw.WithContext(ctx))
+	res, err := vpc.RetryWhenPrivateNetworkNotReady(ctx, d.Timeout(schema.TimeoutCreate), func() (*redis.Cluster, error) {
+		return redisAPI.CreateCluster(createReq, scw.WithContext(ctx))
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -5,6 +5,7 @@ import (
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -148,6 +149,50 @@ func TestAccCluster_Migrate(t *testing.T) {
 	})
 }
 
+func TestAccCluster_MigrateVersion(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	oldestRedisVersion := getOldestVersion(tt)
+	latestRedisVersion := getLatestVersion(tt)
+	config := `
+		resource "scaleway_redis_cluster" "main" {
+		  name         = "test_redis_migrate_version"
+		  version      = "%s"
+		  node_type    = "RED1-XS"
+		  user_name    = "my_initial_user"
+		  password     = "thiZ_is_v&ry_s3cret"
+		  cluster_size = 1
+		}
+	`
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      isClusterDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(config, oldestRedisVersion),
+				Check: resource.ComposeTestCheckFunc(
+					isClusterPresent(tt, "scaleway_redis_cluster.main"),
+					resource.TestCheckResourceAttr("scaleway_redis_cluster.main", "version", oldestRedisVersion),
+				),
+			},
+			{
+				Config: fmt.Sprintf(config, latestRedisVersion),
+				Check: resource.ComposeTestCheckFunc(
+					isClusterPresent(tt, "scaleway_redis_cluster.main"),
+					resource.TestCheckResourceAttr("scaleway_redis_cluster.main", "version", latestRedisVersion),
+				),
+			},
+			{
+				Config:      fmt.Sprintf(config, "99.0.0"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("redis version 99.0.0 is not available"),
+			},
+		},
+	})
+}
+
 func TestAccCluster_MigrateClusterSizeWithIPAMEndpoint(t *testing.T) {
 	tt := acctest.NewTestTools(t)
 	defer tt.Cleanup()
@@ -929,3 +974,16 @@ func getLatestVersion(tt *acctest.TestTools) string {
 	}
 	return ""
 }
+
+func getOldestVersion(tt *acctest.TestTools) string {
+	api := redisSDK.NewAPI(tt.Meta.ScwClient())
+
+	versions, err := api.ListClusterVersions(&redisSDK.ListClusterVersionsRequest{})
+	if err != nil {
+		tt.T.Fatalf("Could not get oldest redis version: %s", err)
+	}
+	if len(versions.Versions) > 0 {
+		return versions.Versions[len(versions.Versions)-1].Version
+	}
+	return ""
+}
@@ -2,15 +2,19 @@ package sdb
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdbSDK "github.com/scaleway/scaleway-sdk-go/api/serverless_sqldb/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
 
 func ResourceDatabase() *schema.Resource {
@@ -49,17 +53,51 @@ func ResourceDatabase() *schema.Resource {
 				Default:     0,
 				Description: "The minimum number of CPU units for your Serverless SQL Database",
 			},
+			"from_backup_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Description:      "The ID of the backup to create the database from",
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: dsf.Locality,
+			},
 			"endpoint": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "endpoint of the database",
 			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the database",
+			},
+			"started": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the database is running, a database with min_cpu set to 0 is stopped when it is not used",
+			},
+			"cpu_current": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of CPU units currently allocated to the database",
+			},
 			"region":     regional.Schema(),
 			"project_id": account.ProjectIDSchema(),
 		},
+		CustomizeDiff: customizeDiffCPURange,
 	}
 }
 
+func customizeDiffCPURange(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	minCPU := diff.Get("min_cpu").(int)
+	maxCPU := diff.Get("max_cpu").(int)
+	if minCPU > maxCPU {
+		return fmt.Errorf("min_cpu (%d) must be lower than or equal to max_cpu (%d)", minCPU, maxCPU)
+	}
+
+	return nil
+}
+
 func ResourceDatabaseCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := newAPIWithRegion(d, m)
 	if err != nil {
@@ -72,7 +110,7 @@ func ResourceDatabaseCreate(ctx context.Context, d *schema.ResourceData, m inter
 		Name:         d.Get("name").(string),
 		CPUMin:       uint32(d.Get("min_cpu").(int)),
 		CPUMax:       uint32(d.Get("max_cpu").(int)),
-		FromBackupID: nil,
+		FromBackupID: types.ExpandStringPtr(locality.ExpandID(d.Get("from_backup_id"))),
 	}, scw.WithContext(ctx))
 	if err != nil {
 		return diag.FromErr(err)
@@ -107,6 +145,9 @@ func ResourceDatabaseRead(ctx context.Context, d *schema.ResourceData, m interfa
 	_ = d.Set("max_cpu", int(database.CPUMax))
 	_ = d.Set("min_cpu", int(database.CPUMin))
 	_ = d.Set("endpoint", database.Endpoint)
+	_ = d.Set("status", database.Status.String())
+	_ = d.Set("started", database.Started)
+	_ = d.Set("cpu_current", int(database.CPUCurrent))
 	_ = d.Set("region", database.Region)
 	_ = d.Set("project_id", database.ProjectID)
 
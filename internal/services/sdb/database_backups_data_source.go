@@ -0,0 +1,109 @@
+package sdb
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdbSDK "github.com/scaleway/scaleway-sdk-go/api/serverless_sqldb/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+func DataSourceDatabaseBackups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceDatabaseBackupsRead,
+		Schema: map[string]*schema.Schema{
+			"database_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The ID of the database to list the backups of",
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+			},
+			"backups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The backups of the database, the most recent first",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the backup",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the backup",
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The size of the backup in bytes",
+						},
+						"db_size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The size of the database when the backup was taken in bytes",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time of the creation of the backup",
+						},
+						"expires_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time of the expiration of the backup",
+						},
+					},
+				},
+			},
+			"region":     regional.Schema(),
+			"project_id": account.ProjectIDSchema(),
+		},
+	}
+}
+
+func DataSourceDatabaseBackupsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, region, err := newAPIWithRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	databaseID := locality.ExpandID(d.Get("database_id"))
+
+	res, err := api.ListDatabaseBackups(&sdbSDK.ListDatabaseBackupsRequest{
+		Region:     region,
+		DatabaseID: databaseID,
+		ProjectID:  types.ExpandStringPtr(d.Get("project_id")),
+		OrderBy:    sdbSDK.ListDatabaseBackupsRequestOrderByCreatedAtDesc,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backups := []interface{}(nil)
+	for _, backup := range res.Backups {
+		backups = append(backups, map[string]interface{}{
+			"id":         regional.NewIDString(region, backup.ID),
+			"status":     backup.Status.String(),
+			"size":       types.FlattenSize(backup.Size),
+			"db_size":    types.FlattenSize(backup.DbSize),
+			"created_at": types.FlattenTime(backup.CreatedAt),
+			"expires_at": types.FlattenTime(backup.ExpiresAt),
+		})
+	}
+
+	d.SetId(datasource.NewRegionalID(databaseID, region))
+	_ = d.Set("database_id", regional.NewIDString(region, databaseID))
+	_ = d.Set("backups", backups)
+	_ = d.Set("region", region)
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package sdb_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccDataSourceServerlessSQLDBDatabaseBackups_Basic(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckServerlessSQLDBDatabaseDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource scaleway_sdb_sql_database main {
+						name = "test-sdb-sql-database-backups"
+					}
+
+					data scaleway_sdb_sql_database_backups main {
+						database_id = scaleway_sdb_sql_database.main.id
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.scaleway_sdb_sql_database_backups.main", "database_id", "scaleway_sdb_sql_database.main", "id"),
+					// Backups are taken daily, a new database has none
+					resource.TestCheckResourceAttr("data.scaleway_sdb_sql_database_backups.main", "backups.#", "0"),
+				),
+			},
+		},
+	})
+}
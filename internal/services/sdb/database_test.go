@@ -2,6 +2,7 @@ package sdb_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -34,6 +35,7 @@ func TestAccServerlessSQLDBDatabase_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr("scaleway_sdb_sql_database.main", "min_cpu", "0"),
 					resource.TestCheckResourceAttr("scaleway_sdb_sql_database.main", "max_cpu", "15"),
 					resource.TestCheckResourceAttrSet("scaleway_sdb_sql_database.main", "endpoint"),
+					resource.TestCheckResourceAttr("scaleway_sdb_sql_database.main", "status", "ready"),
 				),
 			},
 			{
@@ -83,6 +85,31 @@ func TestAccServerlessSQLDBDatabase_Basic(t *testing.T) {
 	})
 }
 
+func TestAccServerlessSQLDBDatabase_InvalidCPURange(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckServerlessSQLDBDatabaseDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource scaleway_sdb_sql_database main {
+						name    = "test-sdb-sql-database-invalid-cpu-range"
+						min_cpu = 8
+						max_cpu = 4
+					}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("min_cpu \\(8\\) must be lower than or equal to max_cpu \\(4\\)"),
+			},
+		},
+	})
+}
+
 func testAccCheckServerlessSQLDBDatabaseExists(tt *acctest.TestTools, n string) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		rs, ok := state.RootModule().Resources[n]
@@ -2,6 +2,7 @@ package secret
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -101,6 +102,28 @@ func Base64Encoded(data []byte) string {
 	return base64.StdEncoding.EncodeToString(data)
 }
 
+// FlattenJSONPayload returns the top level keys of a JSON object payload.
+// String values are returned as is, other values as their JSON encoding.
+// A payload that is not a JSON object returns an empty map.
+func FlattenJSONPayload(data []byte) map[string]string {
+	rawPayload := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &rawPayload); err != nil {
+		return map[string]string{}
+	}
+
+	payload := make(map[string]string, len(rawPayload))
+	for key, rawValue := range rawPayload {
+		var value string
+		if err := json.Unmarshal(rawValue, &value); err == nil {
+			payload[key] = value
+		} else {
+			payload[key] = string(rawValue)
+		}
+	}
+
+	return payload
+}
+
 // updateSecretProtection sets the protected value of a secret to requested one.
 func updateSecretProtection(api *secret.API, region scw.Region, secretID string, protected bool) error {
 	s, err := api.GetSecret(&secret.GetSecretRequest{
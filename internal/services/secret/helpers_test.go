@@ -0,0 +1,43 @@
+package secret_test
+
+import (
+	"testing"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/secret"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenJSONPayload(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		want    map[string]string
+	}{
+		{
+			name:    "not json",
+			payload: "my-secret",
+			want:    map[string]string{},
+		},
+		{
+			name:    "json array",
+			payload: `["a", "b"]`,
+			want:    map[string]string{},
+		},
+		{
+			name:    "string values",
+			payload: `{"username": "admin", "password": "p@ss\"word"}`,
+			want:    map[string]string{"username": "admin", "password": `p@ss"word`},
+		},
+		{
+			name:    "other values",
+			payload: `{"port": 5432, "tls": true, "hosts": ["a", "b"], "extra": null}`,
+			want:    map[string]string{"port": "5432", "tls": "true", "hosts": `["a", "b"]`, "extra": ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, secret.FlattenJSONPayload([]byte(tt.payload)))
+		})
+	}
+}
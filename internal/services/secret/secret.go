@@ -69,10 +69,11 @@ func ResourceSecret() *schema.Resource {
 				Description: "Date and time of secret's creation (RFC 3339 format)",
 			},
 			"path": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Location of the secret in the directory structure.",
-				Default:     "/",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Location of the secret in the directory structure.",
+				Default:          "/",
+				ValidateDiagFunc: verify.IsPath(),
 				DiffSuppressFunc: func(_, oldValue, newValue string, _ *schema.ResourceData) bool {
 					return filepath.Clean(oldValue) == filepath.Clean(newValue)
 				},
@@ -0,0 +1,176 @@
+package secret
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	secret "github.com/scaleway/scaleway-sdk-go/api/secret/v1beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+func DataSourceSecrets() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceSecretsRead,
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Secrets located in this path are listed",
+				ValidateDiagFunc: verify.IsPath(),
+			},
+			"recursive": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "List the secrets located in the sub-paths of path too",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Secrets with this exact name are listed",
+			},
+			"tags": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "Secrets with these exact tags are listed",
+			},
+			"secrets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protected": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"version_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"project_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"region":          regional.Schema(),
+			"project_id":      account.ProjectIDSchema(),
+			"organization_id": account.OrganizationIDSchema(),
+		},
+	}
+}
+
+func DataSourceSecretsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, region, err := newAPIWithRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	recursive := d.Get("recursive").(bool)
+	rawPath, pathExists := d.GetOk("path")
+	secretsPath := path.Clean(rawPath.(string))
+
+	req := &secret.ListSecretsRequest{
+		Region:         region,
+		Name:           types.ExpandStringPtr(d.Get("name")),
+		Tags:           types.ExpandStrings(d.Get("tags")),
+		ProjectID:      types.ExpandStringPtr(d.Get("project_id")),
+		OrganizationID: types.ExpandStringPtr(d.Get("organization_id")),
+	}
+	// The API only filters on exact paths, sub-paths are filtered below.
+	if pathExists && !recursive {
+		req.Path = &secretsPath
+	}
+
+	res, err := api.ListSecrets(req, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secrets := []interface{}(nil)
+	for _, s := range res.Secrets {
+		if pathExists && recursive && !isSecretInPath(s.Path, secretsPath) {
+			continue
+		}
+
+		secrets = append(secrets, map[string]interface{}{
+			"id":            regional.NewIDString(region, s.ID),
+			"name":          s.Name,
+			"path":          s.Path,
+			"description":   types.FlattenStringPtr(s.Description),
+			"tags":          types.FlattenSliceString(s.Tags),
+			"status":        s.Status.String(),
+			"type":          s.Type.String(),
+			"protected":     s.Protected,
+			"version_count": int(s.VersionCount),
+			"created_at":    types.FlattenTime(s.CreatedAt),
+			"updated_at":    types.FlattenTime(s.UpdatedAt),
+			"project_id":    s.ProjectID,
+		})
+	}
+
+	d.SetId(region.String())
+	_ = d.Set("secrets", secrets)
+
+	return nil
+}
+
+// isSecretInPath returns true if secretPath is parentPath or one of its sub-paths.
+func isSecretInPath(secretPath string, parentPath string) bool {
+	secretPath = path.Clean(secretPath)
+	if parentPath == "/" || secretPath == parentPath {
+		return true
+	}
+
+	return strings.HasPrefix(secretPath, parentPath+"/")
+}
@@ -0,0 +1,69 @@
+package secret_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccDataSourceSecrets_Path(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckSecretDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "scaleway_secret" "prod_db" {
+				  name = "dataSourceSecretsDB"
+				  path = "/tf-tests/prod"
+				  tags = ["prod"]
+				}
+
+				resource "scaleway_secret" "prod_api" {
+				  name = "dataSourceSecretsAPI"
+				  path = "/tf-tests/prod/api"
+				  tags = ["prod"]
+				}
+
+				resource "scaleway_secret" "dev_db" {
+				  name = "dataSourceSecretsDB"
+				  path = "/tf-tests/dev"
+				  tags = ["dev"]
+				}
+
+				data "scaleway_secrets" "prod" {
+				  path       = "/tf-tests/prod"
+				  depends_on = [scaleway_secret.prod_db, scaleway_secret.prod_api, scaleway_secret.dev_db]
+				}
+
+				data "scaleway_secrets" "prod_recursive" {
+				  path       = "/tf-tests/prod"
+				  recursive  = true
+				  depends_on = [scaleway_secret.prod_db, scaleway_secret.prod_api, scaleway_secret.dev_db]
+				}
+
+				data "scaleway_secrets" "all" {
+				  path       = "/tf-tests"
+				  recursive  = true
+				  tags       = ["dev"]
+				  depends_on = [scaleway_secret.prod_db, scaleway_secret.prod_api, scaleway_secret.dev_db]
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_secrets.prod", "secrets.#", "1"),
+					resource.TestCheckResourceAttrPair("data.scaleway_secrets.prod", "secrets.0.id", "scaleway_secret.prod_db", "id"),
+					resource.TestCheckResourceAttr("data.scaleway_secrets.prod_recursive", "secrets.#", "2"),
+					resource.TestCheckResourceAttr("data.scaleway_secrets.all", "secrets.#", "1"),
+					resource.TestCheckResourceAttrPair("data.scaleway_secrets.all", "secrets.0.id", "scaleway_secret.dev_db", "id"),
+				),
+			},
+		},
+	})
+}
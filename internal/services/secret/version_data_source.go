@@ -42,6 +42,13 @@ func DataSourceVersion() *schema.Resource {
 		Sensitive:   true,
 		Description: "The payload of the secret version",
 	}
+	dsSchema["data_json"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Sensitive:   true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The keys of the payload of the secret version when it is a JSON object",
+	}
 	dsSchema["organization_id"] = account.OrganizationIDOptionalSchema()
 	dsSchema["project_id"] = &schema.Schema{
 		Type:             schema.TypeString,
@@ -119,6 +126,7 @@ func datasourceSchemaFromResourceVersionSchema(ctx context.Context, d *schema.Re
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	_ = d.Set("data_json", FlattenJSONPayload(payloadSecretRaw))
 
 	diags := ResourceVersionRead(ctx, d, m)
 	if diags != nil {
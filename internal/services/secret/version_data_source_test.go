@@ -188,3 +188,41 @@ func TestAccDataSourceSecretVersion_ByNameSecret(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDataSourceSecretVersion_DataJSON(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckSecretVersionDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "scaleway_secret" "main" {
+				  name = "dataSourceSecretVersionDataJSON"
+				}
+
+				resource "scaleway_secret_version" "v1" {
+				  secret_id = scaleway_secret.main.id
+				  data      = jsonencode({ username = "admin", port = 5432 })
+				}
+
+				data "scaleway_secret_version" "json" {
+				  secret_id = scaleway_secret.main.id
+				  revision  = "1"
+				  depends_on = [scaleway_secret_version.v1]
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_secret_version.json", "data_json.%", "2"),
+					resource.TestCheckResourceAttr("data.scaleway_secret_version.json", "data_json.username", "admin"),
+					resource.TestCheckResourceAttr("data.scaleway_secret_version.json", "data_json.port", "5432"),
+				),
+			},
+		},
+	})
+}
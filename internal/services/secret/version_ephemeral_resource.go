@@ -0,0 +1,196 @@
+package secret
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	secret "github.com/scaleway/scaleway-sdk-go/api/secret/v1beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &VersionEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &VersionEphemeralResource{}
+)
+
+// VersionEphemeralResource gives access to the payload of a secret version without storing it in the state.
+type VersionEphemeralResource struct {
+	meta *meta.Meta
+}
+
+func NewVersionEphemeralResource() ephemeral.EphemeralResource {
+	return &VersionEphemeralResource{}
+}
+
+type versionEphemeralResourceModel struct {
+	SecretID       types.String `tfsdk:"secret_id"`
+	SecretName     types.String `tfsdk:"secret_name"`
+	Revision       types.String `tfsdk:"revision"`
+	Region         types.String `tfsdk:"region"`
+	ProjectID      types.String `tfsdk:"project_id"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	Data           types.String `tfsdk:"data"`
+}
+
+func (r *VersionEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret_version"
+}
+
+func (r *VersionEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Access the payload of a secret version without persisting it in the state",
+		Attributes: map[string]schema.Attribute{
+			"secret_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the secret",
+			},
+			"secret_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The Name of the secret",
+			},
+			"revision": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The revision of secret version, defaults to latest",
+			},
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The region of the secret",
+			},
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the project to filter the secret",
+			},
+			"organization_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the organization to filter the secret",
+			},
+			"data": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The payload of the secret version, base64 encoded",
+			},
+		},
+	}
+}
+
+func (r *VersionEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	m, ok := req.ProviderData.(*meta.Meta)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *meta.Meta, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.meta = m
+}
+
+func (r *VersionEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data versionEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.SecretID.ValueString() == "" && data.SecretName.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("secret_id"), "Missing secret", "One of secret_id or secret_name must be set")
+
+		return
+	}
+
+	api := secret.NewAPI(r.meta.ScwClient())
+	secretID := regional.ExpandID(data.SecretID.ValueString())
+
+	region, err := r.region(data.Region.ValueString(), secretID.Region)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid region", err.Error())
+
+		return
+	}
+
+	if data.SecretID.ValueString() == "" {
+		secretName := data.SecretName.ValueString()
+
+		secrets, err := api.ListSecrets(&secret.ListSecretsRequest{
+			Region:         region,
+			Name:           &secretName,
+			ProjectID:      data.ProjectID.ValueStringPointer(),
+			OrganizationID: data.OrganizationID.ValueStringPointer(),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to list secrets", err.Error())
+
+			return
+		}
+
+		foundSecret, err := datasource.FindExact(secrets.Secrets,
+			func(s *secret.Secret) bool { return s.Name == secretName },
+			secretName,
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to find secret", err.Error())
+
+			return
+		}
+
+		secretID.ID = foundSecret.ID
+	}
+
+	revision := data.Revision.ValueString()
+	if revision == "" {
+		revision = "latest"
+	}
+
+	res, err := api.AccessSecretVersion(&secret.AccessSecretVersionRequest{
+		Region:   region,
+		SecretID: secretID.ID,
+		Revision: revision,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to access secret version", err.Error())
+
+		return
+	}
+
+	data.SecretID = types.StringValue(regional.NewIDString(region, res.SecretID))
+	data.Revision = types.StringValue(fmt.Sprintf("%d", res.Revision))
+	data.Region = types.StringValue(region.String())
+	data.Data = types.StringValue(base64.StdEncoding.EncodeToString(res.Data))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// region returns the region of the secret, from the region argument, the region of the secret ID or the provider default region.
+func (r *VersionEphemeralResource) region(rawRegion string, secretRegion scw.Region) (scw.Region, error) {
+	if rawRegion != "" {
+		return scw.ParseRegion(rawRegion)
+	}
+
+	if secretRegion != "" {
+		return secretRegion, nil
+	}
+
+	region, exist := r.meta.ScwClient().GetDefaultRegion()
+	if !exist {
+		return "", regional.ErrRegionNotFound
+	}
+
+	return region, nil
+}
@@ -0,0 +1,82 @@
+package secret_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccEphemeralResourceSecretVersion_Basic(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"scaleway": tt.ProtoV6ProviderFactories["scaleway"],
+			"echo":     acctest.EchoProviderServer,
+		},
+		CheckDestroy: testAccCheckSecretVersionDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "scaleway_secret" "main" {
+				  name = "ephemeralSecretVersionBasic"
+				}
+
+				resource "scaleway_secret_version" "v1" {
+				  secret_id = scaleway_secret.main.id
+				  data      = "my_super_secret"
+				}
+
+				ephemeral "scaleway_secret_version" "by_id" {
+				  secret_id = scaleway_secret.main.id
+				  revision  = scaleway_secret_version.v1.revision
+				}
+
+				ephemeral "scaleway_secret_version" "by_name" {
+				  secret_name = scaleway_secret.main.name
+				  revision    = "latest"
+				  depends_on  = [scaleway_secret_version.v1]
+				}
+
+				provider "echo" {
+				  alias = "by_id"
+				  data = {
+				    data     = ephemeral.scaleway_secret_version.by_id.data
+				    revision = ephemeral.scaleway_secret_version.by_id.revision
+				  }
+				}
+
+				provider "echo" {
+				  alias = "by_name"
+				  data = {
+				    data     = ephemeral.scaleway_secret_version.by_name.data
+				    revision = ephemeral.scaleway_secret_version.by_name.revision
+				  }
+				}
+
+				resource "echo" "by_id" {
+				  provider = echo.by_id
+				}
+
+				resource "echo" "by_name" {
+				  provider = echo.by_name
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretVersionExists(tt, "scaleway_secret_version.v1"),
+					resource.TestCheckResourceAttr("echo.by_id", "data.data", base64.StdEncoding.EncodeToString([]byte("my_super_secret"))),
+					resource.TestCheckResourceAttr("echo.by_id", "data.revision", "1"),
+					resource.TestCheckResourceAttr("echo.by_name", "data.data", base64.StdEncoding.EncodeToString([]byte("my_super_secret"))),
+					resource.TestCheckResourceAttr("echo.by_name", "data.revision", "1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,152 @@
+package tem
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tem "github.com/scaleway/scaleway-sdk-go/api/tem/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+func DataSourceDomainLastStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceDomainLastStatusRead,
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The ID of the tem domain",
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+			},
+			"revalidate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Ask for a new check of the DNS records of the domain before reading its status",
+			},
+			"domain_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the domain",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the domain",
+			},
+			"spf_record":   domainLastStatusRecordSchema("SPF"),
+			"dkim_record":  domainLastStatusRecordSchema("DKIM"),
+			"dmarc_record": domainLastStatusRecordSchema("DMARC"),
+			"autoconfig_state": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The state of the auto-configuration of the DNS records of the domain",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the auto-configuration is enabled",
+						},
+						"autoconfigurable": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the domain can be auto-configured",
+						},
+						"reason": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The reason why the domain cannot be auto-configured",
+						},
+					},
+				},
+			},
+			"reputation": ResourceDomain().Schema["reputation"],
+			"region":     regional.Schema(),
+		},
+	}
+}
+
+func domainLastStatusRecordSchema(record string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The result of the last check of the " + record + " record of the domain",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"status": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The status of the " + record + " record",
+				},
+				"last_valid_at": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The date and time the " + record + " record was last valid",
+				},
+				"error": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The error of the " + record + " record if it is not valid",
+				},
+			},
+		},
+	}
+}
+
+func DataSourceDomainLastStatusRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, region, err := temAPIWithRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	domainID := locality.ExpandID(d.Get("domain_id"))
+
+	var domain *tem.Domain
+	if d.Get("revalidate").(bool) {
+		domain, err = api.CheckDomain(&tem.CheckDomainRequest{
+			Region:   region,
+			DomainID: domainID,
+		}, scw.WithContext(ctx))
+	} else {
+		domain, err = api.GetDomain(&tem.GetDomainRequest{
+			Region:   region,
+			DomainID: domainID,
+		}, scw.WithContext(ctx))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	status, err := api.GetDomainLastStatus(&tem.GetDomainLastStatusRequest{
+		Region:   region,
+		DomainID: domainID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(datasource.NewRegionalID(domainID, region))
+	_ = d.Set("domain_id", regional.NewIDString(region, domainID))
+	_ = d.Set("domain_name", status.DomainName)
+	_ = d.Set("status", domain.Status.String())
+	_ = d.Set("reputation", flattenDomainReputation(domain.Reputation))
+	if status.SpfRecord != nil {
+		_ = d.Set("spf_record", flattenDomainLastStatusRecord(status.SpfRecord.Status, status.SpfRecord.LastValidAt, status.SpfRecord.Error))
+	}
+	if status.DkimRecord != nil {
+		_ = d.Set("dkim_record", flattenDomainLastStatusRecord(status.DkimRecord.Status, status.DkimRecord.LastValidAt, status.DkimRecord.Error))
+	}
+	if status.DmarcRecord != nil {
+		_ = d.Set("dmarc_record", flattenDomainLastStatusRecord(status.DmarcRecord.Status, status.DmarcRecord.LastValidAt, status.DmarcRecord.Error))
+	}
+	_ = d.Set("autoconfig_state", flattenDomainLastStatusAutoconfigState(status.AutoconfigState))
+	_ = d.Set("region", region)
+
+	return nil
+}
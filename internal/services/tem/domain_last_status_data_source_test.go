@@ -0,0 +1,45 @@
+package tem_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccDataSourceDomainLastStatus_Basic(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	domainName := "test.scaleway-terraform.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "scaleway_tem_domain" "test" {
+						name = "%s"
+					}
+
+					data "scaleway_tem_domain_last_status" "test" {
+						domain_id  = data.scaleway_tem_domain.test.id
+						revalidate = true
+					}
+				`, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_tem_domain_last_status.test", "domain_name", domainName),
+					resource.TestCheckResourceAttrSet("data.scaleway_tem_domain_last_status.test", "status"),
+					resource.TestCheckResourceAttrSet("data.scaleway_tem_domain_last_status.test", "spf_record.0.status"),
+					resource.TestCheckResourceAttrSet("data.scaleway_tem_domain_last_status.test", "dkim_record.0.status"),
+					resource.TestCheckResourceAttrSet("data.scaleway_tem_domain_last_status.test", "dmarc_record.0.status"),
+					resource.TestCheckResourceAttrSet("data.scaleway_tem_domain_last_status.test", "reputation.0.score"),
+				),
+			},
+		},
+	})
+}
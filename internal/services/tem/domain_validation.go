@@ -3,6 +3,7 @@ package tem
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -44,6 +45,13 @@ func ResourceDomainValidation() *schema.Resource {
 				Default:     300,
 				Description: "Maximum wait time in second before returning an error.",
 			},
+			"fail_on_timeout": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Return an error if the SPF and DKIM records of the domain are not valid before the timeout",
+			},
 			"validated": {
 				Type:        schema.TypeBool,
 				Computed:    true,
@@ -72,16 +80,23 @@ func ResourceDomainValidationCreate(ctx context.Context, d *schema.ResourceData,
 	}
 	duration := d.Get("timeout").(int)
 	timeout := time.Duration(duration) * time.Second
-	_ = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+	err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
 		domainCheck, _ := api.CheckDomain(&tem.CheckDomainRequest{
 			Region:   region,
 			DomainID: domain.ID,
-		})
+		}, scw.WithContext(ctx))
 		if domainCheck == nil || domainCheck.Status == "pending" || domainCheck.Status == "unchecked" || domainCheck.Status == "autoconfiguring" {
 			return retry.RetryableError(errors.New("retry"))
 		}
+		if domainCheck.Status != tem.DomainStatusChecked && d.Get("fail_on_timeout").(bool) {
+			return retry.RetryableError(fmt.Errorf("domain is %s", domainCheck.Status))
+		}
 		return nil
 	})
+	if err != nil && d.Get("fail_on_timeout").(bool) {
+		d.SetId("")
+		return diag.FromErr(domainValidationError(ctx, api, region, domain.ID))
+	}
 
 	return ResourceDomainValidationRead(ctx, d, meta)
 }
@@ -116,6 +131,34 @@ func ResourceDomainValidationDelete(_ context.Context, d *schema.ResourceData, _
 	return nil
 }
 
+// domainValidationError returns an error describing the records of a domain that are not valid yet.
+func domainValidationError(ctx context.Context, api *tem.API, region scw.Region, domainID string) error {
+	status, err := api.GetDomainLastStatus(&tem.GetDomainLastStatusRequest{
+		Region:   region,
+		DomainID: domainID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("domain %s was not validated before the timeout: %w", domainID, err)
+	}
+
+	invalidRecords := []string(nil)
+	if status.SpfRecord != nil && status.SpfRecord.Status != tem.DomainLastStatusRecordStatusValid {
+		invalidRecords = append(invalidRecords, fmt.Sprintf("SPF is %s", status.SpfRecord.Status))
+	}
+	if status.DkimRecord != nil && status.DkimRecord.Status != tem.DomainLastStatusRecordStatusValid {
+		invalidRecords = append(invalidRecords, fmt.Sprintf("DKIM is %s", status.DkimRecord.Status))
+	}
+	if status.DmarcRecord != nil && status.DmarcRecord.Status != tem.DomainLastStatusRecordStatusValid {
+		invalidRecords = append(invalidRecords, fmt.Sprintf("DMARC is %s", status.DmarcRecord.Status))
+	}
+
+	if len(invalidRecords) == 0 {
+		return fmt.Errorf("domain %s was not validated before the timeout", status.DomainName)
+	}
+
+	return fmt.Errorf("domain %s was not validated before the timeout: %s", status.DomainName, strings.Join(invalidRecords, ", "))
+}
+
 func extractAfterSlash(s string) string {
 	lastIndex := strings.LastIndex(s, "/")
 	if lastIndex == -1 {
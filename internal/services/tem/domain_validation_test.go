@@ -2,6 +2,7 @@ package tem_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -84,3 +85,41 @@ func TestAccDomainValidation_Validation(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDomainValidation_FailOnTimeout(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	subDomainName := "validation-fail-on-timeout"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      isDomainDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+
+					resource "scaleway_domain_zone" "test" {
+  						domain    = "%s"
+  						subdomain = "%s"
+					}
+
+					resource scaleway_tem_domain cr01 {
+						name       = scaleway_domain_zone.test.id
+						accept_tos = true
+					}
+
+					resource scaleway_tem_domain_validation valid {
+  						domain_id       = scaleway_tem_domain.cr01.id
+  						region          = scaleway_tem_domain.cr01.region
+						timeout         = 1
+						fail_on_timeout = true
+					}
+				`, domainNameValidation, subDomainName),
+				ExpectError: regexp.MustCompile("was not validated before the timeout"),
+			},
+		},
+	})
+}
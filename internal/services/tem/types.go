@@ -1,6 +1,8 @@
 package tem
 
 import (
+	"time"
+
 	tem "github.com/scaleway/scaleway-sdk-go/api/tem/v1alpha1"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
@@ -20,6 +22,35 @@ func flattenDomainReputation(reputation *tem.DomainReputation) interface{} {
 	}
 }
 
+func flattenDomainLastStatusRecord(status tem.DomainLastStatusRecordStatus, lastValidAt *time.Time, recordError *string) interface{} {
+	return []map[string]interface{}{
+		{
+			"status":        status.String(),
+			"last_valid_at": types.FlattenTime(lastValidAt),
+			"error":         types.FlattenStringPtr(recordError),
+		},
+	}
+}
+
+func flattenDomainLastStatusAutoconfigState(state *tem.DomainLastStatusAutoconfigState) interface{} {
+	if state == nil {
+		return nil
+	}
+
+	reason := ""
+	if state.Reason != nil {
+		reason = state.Reason.String()
+	}
+
+	return []map[string]interface{}{
+		{
+			"enabled":          state.Enabled,
+			"autoconfigurable": state.Autoconfigurable,
+			"reason":           reason,
+		},
+	}
+}
+
 func expandWebhookEventTypes(eventTypesInterface []interface{}) []tem.WebhookEventType {
 	eventTypes := make([]tem.WebhookEventType, len(eventTypesInterface))
 	for i, v := range eventTypesInterface {
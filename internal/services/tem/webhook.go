@@ -12,6 +12,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
 
 func ResourceWebhook() *schema.Resource {
@@ -41,8 +42,8 @@ func ResourceWebhook() *schema.Resource {
 				Type:     schema.TypeList,
 				Required: true,
 				Elem: &schema.Schema{
-					Type:         schema.TypeString,
-					ValidateFunc: validation.StringNotInSlice([]string{"unknown_type"}, false),
+					Type:             schema.TypeString,
+					ValidateDiagFunc: verify.ValidateEnum[tem.WebhookEventType](),
 				},
 				Description: "List of event types",
 				MinItems:    1,
@@ -197,7 +197,7 @@ func ResourceVPCPrivateNetworkCreate(ctx context.Context, d *schema.ResourceData
 	}
 
 	req := &vpc.CreatePrivateNetworkRequest{
-		Name:      types.ExpandOrGenerateString(d.Get("name"), "pn"),
+		Name:      meta.ExpandOrGenerateName(m, d.Get("name"), "pn"),
 		Tags:      types.ExpandStrings(d.Get("tags")),
 		ProjectID: d.Get("project_id").(string),
 		Region:    region,
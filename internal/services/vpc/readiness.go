@@ -0,0 +1,60 @@
+package vpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
+)
+
+const (
+	defaultPrivateNetworkReadyRetryInterval = 5 * time.Second
+	// privateNetworkReadyTimeout bounds the retries, a private network which is not propagated after it does not exist
+	privateNetworkReadyTimeout = 2 * time.Minute
+)
+
+// privateNetworkResources are the resource names used by the APIs in the errors about a private network or its subnets
+var privateNetworkResources = []string{"private_network", "subnet", "ipam_subnet"}
+
+// IsPrivateNetworkNotReadyError returns whether the error was returned by an API because the private network,
+// or one of its IPAM subnets, is not propagated to this API yet. These errors are transient just after the creation of the private network.
+func IsPrivateNetworkNotReadyError(err error) bool {
+	var notFoundError *scw.ResourceNotFoundError
+	if errors.As(err, &notFoundError) {
+		return slices.Contains(privateNetworkResources, notFoundError.Resource)
+	}
+
+	var transientStateError *scw.TransientStateError
+	if errors.As(err, &transientStateError) {
+		return slices.Contains(privateNetworkResources, transientStateError.Resource)
+	}
+
+	var responseError *scw.ResponseError
+	if errors.As(err, &responseError) && (responseError.StatusCode == http.StatusNotFound || responseError.StatusCode == http.StatusPreconditionFailed) {
+		if slices.Contains(privateNetworkResources, responseError.Resource) {
+			return true
+		}
+
+		message := strings.ToLower(responseError.Message)
+
+		return strings.Contains(message, "private network") || strings.Contains(message, "private_network")
+	}
+
+	return false
+}
+
+// RetryWhenPrivateNetworkNotReady retries the action while it fails because the private network it depends on is not ready,
+// for at most two minutes or until timeout is reached. When the private network is still not ready, the last error is returned.
+// It is used by the resources attaching to a private network (database endpoints, load balancers, clusters, ...) to create them consistently right after the private network.
+func RetryWhenPrivateNetworkNotReady[T any](ctx context.Context, timeout time.Duration, action func() (T, error)) (T, error) { //nolint: ireturn
+	return transport.RetryWhenErrorMatches(ctx, IsPrivateNetworkNotReadyError, &transport.RetryWhenConfig[T]{
+		Timeout:  min(timeout, privateNetworkReadyTimeout),
+		Interval: defaultPrivateNetworkReadyRetryInterval,
+		Function: action,
+	})
+}
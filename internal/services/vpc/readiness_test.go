@@ -0,0 +1,39 @@
+package vpc_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/vpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsPrivateNetworkNotReadyError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{"private network not found", &scw.ResourceNotFoundError{Resource: "private_network"}, true},
+		{"subnet not found", &scw.ResourceNotFoundError{Resource: "subnet"}, true},
+		{"instance not found", &scw.ResourceNotFoundError{Resource: "instance"}, false},
+		{"private network transient state", &scw.TransientStateError{Resource: "private_network"}, true},
+		{"wrapped", fmt.Errorf("failed to attach: %w", &scw.ResourceNotFoundError{Resource: "private_network"}), true},
+		{"not found response", &scw.ResponseError{StatusCode: http.StatusNotFound, Message: "Private Network not found"}, true},
+		{"precondition response", &scw.ResponseError{StatusCode: http.StatusPreconditionFailed, Message: "private network is not ready"}, true},
+		{"precondition response on subnet resource", &scw.ResponseError{StatusCode: http.StatusPreconditionFailed, Resource: "subnet"}, true},
+		{"other subnet response", &scw.ResponseError{StatusCode: http.StatusNotFound, Message: "subnet of the load balancer not found"}, false},
+		{"other response", &scw.ResponseError{StatusCode: http.StatusBadRequest, Message: "invalid private network"}, false},
+		{"other error", errors.New("private network not found"), false},
+		{"nil", nil, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, vpc.IsPrivateNetworkNotReadyError(test.err))
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
@@ -83,7 +84,7 @@ func ResourceVPCCreate(ctx context.Context, d *schema.ResourceData, m interface{
 	}
 
 	res, err := vpcAPI.CreateVPC(&vpc.CreateVPCRequest{
-		Name:          types.ExpandOrGenerateString(d.Get("name"), "vpc"),
+		Name:          meta.ExpandOrGenerateName(m, d.Get("name"), "vpc"),
 		Tags:          types.ExpandStrings(d.Get("tags")),
 		EnableRouting: d.Get("enable_routing").(bool),
 		ProjectID:     d.Get("project_id").(string),
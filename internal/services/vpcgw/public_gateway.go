@@ -11,6 +11,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
@@ -121,7 +122,7 @@ func ResourceVPCPublicGatewayCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	req := &vpcgw.CreateGatewayRequest{
-		Name:               types.ExpandOrGenerateString(d.Get("name"), "pn"),
+		Name:               meta.ExpandOrGenerateName(m, d.Get("name"), "pn"),
 		Type:               d.Get("type").(string),
 		Tags:               types.ExpandStrings(d.Get("tags")),
 		UpstreamDNSServers: types.ExpandStrings(d.Get("upstream_dns_servers")),
@@ -0,0 +1,138 @@
+package webhosting
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	webhosting "github.com/scaleway/scaleway-sdk-go/api/webhosting/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+)
+
+func DataSourceDNSRecords() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceDNSRecordsRead,
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The domain of the hosting",
+			},
+			"records": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The DNS records required by the hosting",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name_servers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The name servers required by the hosting",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hostname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_default": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the DNS configuration of the domain",
+			},
+			"region": regional.Schema(),
+		},
+	}
+}
+
+func DataSourceDNSRecordsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, region, err := newAPIWithRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	domain := d.Get("domain").(string)
+
+	res, err := api.GetDomainDNSRecords(&webhosting.GetDomainDNSRecordsRequest{
+		Region: region,
+		Domain: domain,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(datasource.NewRegionalID(domain, region))
+	_ = d.Set("records", flattenDNSRecords(res.Records))
+	_ = d.Set("name_servers", flattenNameServers(res.NameServers))
+	_ = d.Set("status", res.Status.String())
+	_ = d.Set("region", string(region))
+
+	return nil
+}
+
+func flattenDNSRecords(records []*webhosting.DNSRecord) []map[string]interface{} {
+	flattenedRecords := []map[string]interface{}(nil)
+	for _, record := range records {
+		flattenedRecords = append(flattenedRecords, map[string]interface{}{
+			"name":     record.Name,
+			"type":     record.Type.String(),
+			"ttl":      int(record.TTL),
+			"value":    record.Value,
+			"priority": types.FlattenUint32Ptr(record.Priority),
+			"status":   record.Status.String(),
+		})
+	}
+	return flattenedRecords
+}
+
+func flattenNameServers(nameServers []*webhosting.Nameserver) []map[string]interface{} {
+	flattenedNameServers := []map[string]interface{}(nil)
+	for _, nameServer := range nameServers {
+		flattenedNameServers = append(flattenedNameServers, map[string]interface{}{
+			"hostname":   nameServer.Hostname,
+			"status":     nameServer.Status.String(),
+			"is_default": nameServer.IsDefault,
+		})
+	}
+	return flattenedNameServers
+}
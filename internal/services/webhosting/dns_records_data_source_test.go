@@ -0,0 +1,45 @@
+package webhosting_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccDataSourceWebhostingDNSRecords_Basic(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckWebhostingDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "scaleway_webhosting_offer" "by_name" {
+					  name = "lite"
+					}
+
+					resource "scaleway_webhosting" "main" {
+					  offer_id = data.scaleway_webhosting_offer.by_name.offer_id
+					  email    = "hashicorp@scaleway.com"
+					  domain   = "scaleway.com"
+					}
+
+					data "scaleway_webhosting_dns_records" "main" {
+					  domain = scaleway_webhosting.main.domain
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scaleway_webhosting_dns_records.main", "records.0.type"),
+					resource.TestCheckResourceAttrSet("data.scaleway_webhosting_dns_records.main", "records.0.value"),
+					resource.TestCheckResourceAttrSet("data.scaleway_webhosting_dns_records.main", "status"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,182 @@
+package webhosting
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	webhostingV1 "github.com/scaleway/scaleway-sdk-go/api/webhosting/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/cdf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+func ResourceFtpAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFtpAccountCreate,
+		ReadContext:   resourceFtpAccountRead,
+		UpdateContext: resourceFtpAccountUpdate,
+		DeleteContext: resourceFtpAccountDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(defaultHostingTimeout),
+			Read:    schema.DefaultTimeout(defaultHostingTimeout),
+			Update:  schema.DefaultTimeout(defaultHostingTimeout),
+			Delete:  schema.DefaultTimeout(defaultHostingTimeout),
+			Default: schema.DefaultTimeout(defaultHostingTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"hosting_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+				Description:      "The ID of the hosting the FTP account belongs to",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The username of the FTP account",
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the FTP account, relative to the hosting home directory",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The password of the FTP account",
+			},
+			"region": regional.Schema(),
+		},
+		CustomizeDiff: cdf.LocalityCheck("hosting_id"),
+	}
+}
+
+func resourceFtpAccountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, region, err := newFtpAccountAPIWithRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	hostingAPI, _, err := newAPIWithRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	hostingID := locality.ExpandID(d.Get("hosting_id"))
+
+	_, err = waitForHosting(ctx, hostingAPI, region, hostingID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	account, err := api.CreateFtpAccount(&webhostingV1.FtpAccountAPICreateFtpAccountRequest{
+		Region:    region,
+		HostingID: hostingID,
+		Username:  d.Get("username").(string),
+		Path:      d.Get("path").(string),
+		Password:  d.Get("password").(string),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(NewAccountID(region, hostingID, account.Username))
+
+	return resourceFtpAccountRead(ctx, d, m)
+}
+
+func resourceFtpAccountRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := webhostingV1.NewFtpAccountAPI(meta.ExtractScwClient(m))
+
+	region, hostingID, username, err := ParseAccountID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := api.ListFtpAccounts(&webhostingV1.FtpAccountAPIListFtpAccountsRequest{
+		Region:    region,
+		HostingID: hostingID,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		if httperrors.Is404(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	var account *webhostingV1.FtpAccount
+	for _, ftpAccount := range res.FtpAccounts {
+		if ftpAccount.Username == username {
+			account = ftpAccount
+			break
+		}
+	}
+	if account == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("hosting_id", regional.NewIDString(region, hostingID))
+	_ = d.Set("username", account.Username)
+	_ = d.Set("path", account.Path)
+	_ = d.Set("region", string(region))
+
+	return nil
+}
+
+func resourceFtpAccountUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := webhostingV1.NewFtpAccountAPI(meta.ExtractScwClient(m))
+
+	region, hostingID, username, err := ParseAccountID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("password") {
+		_, err = api.ChangeFtpAccountPassword(&webhostingV1.FtpAccountAPIChangeFtpAccountPasswordRequest{
+			Region:    region,
+			HostingID: hostingID,
+			Username:  username,
+			Password:  d.Get("password").(string),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceFtpAccountRead(ctx, d, m)
+}
+
+func resourceFtpAccountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := webhostingV1.NewFtpAccountAPI(meta.ExtractScwClient(m))
+
+	region, hostingID, username, err := ParseAccountID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = api.RemoveFtpAccount(&webhostingV1.FtpAccountAPIRemoveFtpAccountRequest{
+		Region:    region,
+		HostingID: hostingID,
+		Username:  username,
+	}, scw.WithContext(ctx))
+	if err != nil && !httperrors.Is404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package webhosting_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	webhostingV1SDK "github.com/scaleway/scaleway-sdk-go/api/webhosting/v1"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/webhosting"
+)
+
+func TestAccWebhostingFtpAccount_Basic(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckWebhostingDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "scaleway_webhosting_offer" "by_name" {
+					  name = "lite"
+					}
+
+					resource "scaleway_webhosting" "main" {
+					  offer_id = data.scaleway_webhosting_offer.by_name.offer_id
+					  email    = "hashicorp@scaleway.com"
+					  domain   = "scaleway.com"
+					}
+
+					resource "scaleway_webhosting_ftp_account" "main" {
+					  hosting_id = scaleway_webhosting.main.id
+					  username   = "tf-test"
+					  path       = "/public_html"
+					  password   = "Th1s-1s-a-P4ssw0rd"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebhostingFtpAccountExists(tt, "scaleway_webhosting_ftp_account.main"),
+					resource.TestCheckResourceAttr("scaleway_webhosting_ftp_account.main", "username", "tf-test"),
+					resource.TestCheckResourceAttr("scaleway_webhosting_ftp_account.main", "path", "/public_html"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckWebhostingFtpAccountExists(tt *acctest.TestTools, n string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", n)
+		}
+
+		region, hostingID, username, err := webhosting.ParseAccountID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		api := webhostingV1SDK.NewFtpAccountAPI(meta.ExtractScwClient(tt.Meta))
+		res, err := api.ListFtpAccounts(&webhostingV1SDK.FtpAccountAPIListFtpAccountsRequest{
+			Region:    region,
+			HostingID: hostingID,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, account := range res.FtpAccounts {
+			if account.Username == username {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("ftp account %s not found", username)
+	}
+}
@@ -2,9 +2,12 @@ package webhosting
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	webhostingV1 "github.com/scaleway/scaleway-sdk-go/api/webhosting/v1"
 	webhosting "github.com/scaleway/scaleway-sdk-go/api/webhosting/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
@@ -39,6 +42,42 @@ func NewAPIWithRegionAndID(m interface{}, id string) (*webhosting.API, scw.Regio
 	return api, region, id, nil
 }
 
+// newMailAccountAPIWithRegion returns a new Webhosting mail account API and the region for a Create request
+func newMailAccountAPIWithRegion(d *schema.ResourceData, m interface{}) (*webhostingV1.MailAccountAPI, scw.Region, error) {
+	api := webhostingV1.NewMailAccountAPI(meta.ExtractScwClient(m))
+
+	region, err := meta.ExtractRegion(d, m)
+	if err != nil {
+		return nil, "", err
+	}
+	return api, region, nil
+}
+
+// newFtpAccountAPIWithRegion returns a new Webhosting FTP account API and the region for a Create request
+func newFtpAccountAPIWithRegion(d *schema.ResourceData, m interface{}) (*webhostingV1.FtpAccountAPI, scw.Region, error) {
+	api := webhostingV1.NewFtpAccountAPI(meta.ExtractScwClient(m))
+
+	region, err := meta.ExtractRegion(d, m)
+	if err != nil {
+		return nil, "", err
+	}
+	return api, region, nil
+}
+
+// NewAccountID builds the ID of an account of a hosting: {region}/{hosting_id}/{username}
+func NewAccountID(region scw.Region, hostingID string, username string) string {
+	return fmt.Sprintf("%s/%s/%s", region, hostingID, username)
+}
+
+// ParseAccountID parses the ID of an account of a hosting
+func ParseAccountID(resourceID string) (region scw.Region, hostingID string, username string, err error) {
+	idParts := strings.Split(resourceID, "/")
+	if len(idParts) != 3 {
+		return "", "", "", fmt.Errorf("can't parse account resource id: %s", resourceID)
+	}
+	return scw.Region(idParts[0]), idParts[1], idParts[2], nil
+}
+
 func waitForHosting(ctx context.Context, api *webhosting.API, region scw.Region, hostingID string, timeout time.Duration) (*webhosting.Hosting, error) {
 	retryInterval := hostingRetryInterval
 	if transport.DefaultWaitRetryInterval != nil {
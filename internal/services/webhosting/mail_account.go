@@ -0,0 +1,208 @@
+package webhosting
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	webhostingV1 "github.com/scaleway/scaleway-sdk-go/api/webhosting/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/cdf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+func ResourceMailAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMailAccountCreate,
+		ReadContext:   resourceMailAccountRead,
+		UpdateContext: resourceMailAccountUpdate,
+		DeleteContext: resourceMailAccountDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(defaultHostingTimeout),
+			Read:    schema.DefaultTimeout(defaultHostingTimeout),
+			Update:  schema.DefaultTimeout(defaultHostingTimeout),
+			Delete:  schema.DefaultTimeout(defaultHostingTimeout),
+			Default: schema.DefaultTimeout(defaultHostingTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"hosting_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+				Description:      "The ID of the hosting the mail account belongs to",
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The domain of the mail account",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The username of the mail account, the part before the @",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The password of the mail account",
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The email address of the mail account",
+			},
+			"region": regional.Schema(),
+		},
+		CustomizeDiff: cdf.LocalityCheck("hosting_id"),
+	}
+}
+
+func resourceMailAccountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, region, err := newMailAccountAPIWithRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	hostingAPI, _, err := newAPIWithRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	hostingID := locality.ExpandID(d.Get("hosting_id"))
+
+	_, err = waitForHosting(ctx, hostingAPI, region, hostingID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	account, err := api.CreateMailAccount(&webhostingV1.MailAccountAPICreateMailAccountRequest{
+		Region:    region,
+		HostingID: hostingID,
+		Domain:    d.Get("domain").(string),
+		Username:  d.Get("username").(string),
+		Password:  d.Get("password").(string),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(NewAccountID(region, hostingID, account.Username+"@"+account.Domain))
+
+	return resourceMailAccountRead(ctx, d, m)
+}
+
+func resourceMailAccountRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := webhostingV1.NewMailAccountAPI(meta.ExtractScwClient(m))
+
+	region, hostingID, username, domain, err := parseMailAccountID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := api.ListMailAccounts(&webhostingV1.MailAccountAPIListMailAccountsRequest{
+		Region:    region,
+		HostingID: hostingID,
+		Domain:    &domain,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		if httperrors.Is404(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	var account *webhostingV1.MailAccount
+	for _, mailAccount := range res.MailAccounts {
+		if mailAccount.Username == username && mailAccount.Domain == domain {
+			account = mailAccount
+			break
+		}
+	}
+	if account == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("hosting_id", regional.NewIDString(region, hostingID))
+	_ = d.Set("domain", account.Domain)
+	_ = d.Set("username", account.Username)
+	_ = d.Set("email", account.Username+"@"+account.Domain)
+	_ = d.Set("region", string(region))
+
+	return nil
+}
+
+func resourceMailAccountUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := webhostingV1.NewMailAccountAPI(meta.ExtractScwClient(m))
+
+	region, hostingID, username, domain, err := parseMailAccountID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("password") {
+		_, err = api.ChangeMailAccountPassword(&webhostingV1.MailAccountAPIChangeMailAccountPasswordRequest{
+			Region:    region,
+			HostingID: hostingID,
+			Domain:    domain,
+			Username:  username,
+			Password:  d.Get("password").(string),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceMailAccountRead(ctx, d, m)
+}
+
+func resourceMailAccountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := webhostingV1.NewMailAccountAPI(meta.ExtractScwClient(m))
+
+	region, hostingID, username, domain, err := parseMailAccountID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = api.RemoveMailAccount(&webhostingV1.MailAccountAPIRemoveMailAccountRequest{
+		Region:    region,
+		HostingID: hostingID,
+		Domain:    domain,
+		Username:  username,
+	}, scw.WithContext(ctx))
+	if err != nil && !httperrors.Is404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// parseMailAccountID parses a mail account ID of the form {region}/{hosting_id}/{username}@{domain}
+func parseMailAccountID(resourceID string) (region scw.Region, hostingID string, username string, domain string, err error) {
+	region, hostingID, email, err := ParseAccountID(resourceID)
+	if err != nil {
+		return "", "", "", "", err
+	}
+
+	at := strings.LastIndex(email, "@")
+	if at <= 0 || at == len(email)-1 {
+		return "", "", "", "", fmt.Errorf("can't parse mail account resource id: %s", resourceID)
+	}
+
+	return region, hostingID, email[:at], email[at+1:], nil
+}
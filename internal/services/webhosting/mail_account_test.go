@@ -0,0 +1,100 @@
+package webhosting_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	webhostingV1SDK "github.com/scaleway/scaleway-sdk-go/api/webhosting/v1"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/webhosting"
+)
+
+func TestAccWebhostingMailAccount_Basic(t *testing.T) {
+	acctest.SkipWithoutCassette(t)
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	config := `
+		data "scaleway_webhosting_offer" "by_name" {
+		  name = "lite"
+		}
+
+		resource "scaleway_webhosting" "main" {
+		  offer_id = data.scaleway_webhosting_offer.by_name.offer_id
+		  email    = "hashicorp@scaleway.com"
+		  domain   = "scaleway.com"
+		}
+
+		resource "scaleway_webhosting_mail_account" "main" {
+		  hosting_id = scaleway_webhosting.main.id
+		  domain     = scaleway_webhosting.main.domain
+		  username   = "tf-test"
+		  password   = "%s"
+		}
+	`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckWebhostingDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(config, "Th1s-1s-a-P4ssw0rd"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebhostingMailAccountExists(tt, "scaleway_webhosting_mail_account.main"),
+					resource.TestCheckResourceAttr("scaleway_webhosting_mail_account.main", "username", "tf-test"),
+					resource.TestCheckResourceAttr("scaleway_webhosting_mail_account.main", "email", "tf-test@scaleway.com"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(config, "Th1s-1s-4n0ther-P4ssw0rd"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebhostingMailAccountExists(tt, "scaleway_webhosting_mail_account.main"),
+					resource.TestCheckResourceAttr("scaleway_webhosting_mail_account.main", "password", "Th1s-1s-4n0ther-P4ssw0rd"),
+				),
+			},
+			{
+				ResourceName:            "scaleway_webhosting_mail_account.main",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testAccCheckWebhostingMailAccountExists(tt *acctest.TestTools, n string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", n)
+		}
+
+		region, hostingID, email, err := webhosting.ParseAccountID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		api := webhostingV1SDK.NewMailAccountAPI(meta.ExtractScwClient(tt.Meta))
+		res, err := api.ListMailAccounts(&webhostingV1SDK.MailAccountAPIListMailAccountsRequest{
+			Region:    region,
+			HostingID: hostingID,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, account := range res.MailAccounts {
+			if strings.EqualFold(account.Username+"@"+account.Domain, email) {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("mail account %s not found", email)
+	}
+}
@@ -0,0 +1,54 @@
+package transport
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// EndpointsTransport sends the requests of some API products to custom endpoints.
+// Scaleway API paths are of the form /{product}/{version}/..., the product is used as key.
+type EndpointsTransport struct {
+	next      http.RoundTripper
+	endpoints map[string]*url.URL
+}
+
+// NewEndpointsTransport creates a transport overriding the endpoint of the given API products
+func NewEndpointsTransport(next http.RoundTripper, endpoints map[string]string) (http.RoundTripper, error) {
+	parsedEndpoints := make(map[string]*url.URL, len(endpoints))
+	for product, endpoint := range endpoints {
+		parsedEndpoint, err := url.Parse(endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid endpoint for %s: %w", product, err)
+		}
+		if parsedEndpoint.Scheme == "" || parsedEndpoint.Host == "" {
+			return nil, fmt.Errorf("invalid endpoint for %s: %s is not an absolute URL", product, endpoint)
+		}
+		parsedEndpoints[product] = parsedEndpoint
+	}
+
+	return &EndpointsTransport{
+		next:      next,
+		endpoints: parsedEndpoints,
+	}, nil
+}
+
+// RoundTrip rewrites the scheme, host and path prefix of the request when its product has a custom endpoint.
+func (t *EndpointsTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	product, _, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+
+	endpoint, ok := t.endpoints[product]
+	if !ok {
+		return t.next.RoundTrip(r)
+	}
+
+	r = r.Clone(r.Context())
+	r.URL.Scheme = endpoint.Scheme
+	r.URL.Host = endpoint.Host
+	r.URL.Path = strings.TrimSuffix(endpoint.Path, "/") + r.URL.Path
+	r.URL.RawPath = ""
+	r.Host = endpoint.Host
+
+	return t.next.RoundTrip(r)
+}
@@ -0,0 +1,45 @@
+package transport_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpointsTransport(t *testing.T) {
+	var proxyPaths, defaultPaths []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		proxyPaths = append(proxyPaths, r.URL.Path)
+	}))
+	defer proxy.Close()
+	defaultServer := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		defaultPaths = append(defaultPaths, r.URL.Path)
+	}))
+	defer defaultServer.Close()
+
+	endpointsTransport, err := transport.NewEndpointsTransport(http.DefaultTransport, map[string]string{
+		"instance": proxy.URL + "/scaleway/",
+	})
+	require.NoError(t, err)
+	client := &http.Client{Transport: endpointsTransport}
+
+	for _, path := range []string{"/instance/v1/zones/fr-par-1/servers", "/rdb/v1/regions/fr-par/instances"} {
+		resp, err := client.Get(defaultServer.URL + path)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	assert.Equal(t, []string{"/scaleway/instance/v1/zones/fr-par-1/servers"}, proxyPaths)
+	assert.Equal(t, []string{"/rdb/v1/regions/fr-par/instances"}, defaultPaths)
+}
+
+func TestEndpointsTransport_InvalidEndpoint(t *testing.T) {
+	_, err := transport.NewEndpointsTransport(http.DefaultTransport, map[string]string{
+		"instance": "proxy.internal",
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,95 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+const defaultTransientErrorRetryInterval = 5 * time.Second
+
+// transientErrorTypes are the types of the errors returned by the APIs while a resource is busy
+var transientErrorTypes = map[string]bool{
+	"transient_state": true,
+	"locked":          true,
+}
+
+// IsTransientError returns whether an error returned by a Scaleway API is transient, i.e. the same request may succeed later:
+// the resource is in a transient state or locked (409, 423), the API is rate-limited (429) or unavailable (500, 502, 503, 504),
+// or a resource is still in use by another one being deleted (412).
+func IsTransientError(err error) bool {
+	var transientStateError *scw.TransientStateError
+	if errors.As(err, &transientStateError) {
+		return true
+	}
+
+	var lockedError *scw.ResourceLockedError
+	if errors.As(err, &lockedError) {
+		return true
+	}
+
+	var preconditionFailedError *scw.PreconditionFailedError
+	if errors.As(err, &preconditionFailedError) {
+		return preconditionFailedError.Precondition == "resource_still_in_use"
+	}
+
+	var responseError *scw.ResponseError
+	if errors.As(err, &responseError) {
+		switch responseError.StatusCode {
+		case http.StatusConflict:
+			return transientErrorTypes[responseError.Type]
+		case http.StatusLocked,
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout:
+			return true
+		}
+	}
+
+	return false
+}
+
+// RetryOnTransientError retries the action while it returns a transient error, until timeout is reached or the context is cancelled
+func RetryOnTransientError[T any](ctx context.Context, timeout time.Duration, action func() (T, error)) (T, error) { //nolint: ireturn
+	return RetryWhenErrorMatches(ctx, IsTransientError, &RetryWhenConfig[T]{
+		Timeout:  timeout,
+		Interval: defaultTransientErrorRetryInterval,
+		Function: action,
+	})
+}
+
+// isTransientStateResponse returns whether the response is a conflict because the resource is busy.
+// The other conflicts (e.g. a resource that already exists) are not transient and are not retried.
+func isTransientStateResponse(resp *http.Response) bool {
+	if resp.StatusCode != http.StatusConflict && resp.StatusCode != http.StatusLocked {
+		return false
+	}
+
+	if resp.Body == nil {
+		return false
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return false
+	}
+
+	apiError := struct {
+		Type string `json:"type"`
+	}{}
+	if err := json.Unmarshal(body, &apiError); err != nil {
+		return false
+	}
+
+	return transientErrorTypes[apiError.Type]
+}
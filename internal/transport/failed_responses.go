@@ -0,0 +1,98 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"sync"
+)
+
+const (
+	requestIDHeader = "X-Request-Id"
+	// maxFailedResponseBodySize limits the size of the bodies kept in memory, error bodies are usually a few hundred bytes
+	maxFailedResponseBodySize = 4096
+)
+
+// FailedResponse describes an API request which received an error status
+type FailedResponse struct {
+	RequestID  string
+	Method     string
+	URL        string
+	Status     string
+	StatusCode int
+	Body       []byte
+}
+
+// FailedResponses collects the failed responses of the requests made with a context returned by ContextWithFailedResponses
+type FailedResponses struct {
+	mu        sync.Mutex
+	responses []*FailedResponse
+}
+
+// All returns the failed responses in the order they were received
+func (f *FailedResponses) All() []*FailedResponse {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]*FailedResponse(nil), f.responses...)
+}
+
+func (f *FailedResponses) add(response *FailedResponse) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.responses = append(f.responses, response)
+}
+
+type failedResponsesKey struct{}
+
+// ContextWithFailedResponses returns a context in which the failed responses of the API requests are collected.
+// Only the requests sent through a FailedResponsesTransport are collected.
+func ContextWithFailedResponses(ctx context.Context) (context.Context, *FailedResponses) {
+	failedResponses := &FailedResponses{}
+
+	return context.WithValue(ctx, failedResponsesKey{}, failedResponses), failedResponses
+}
+
+// FailedResponsesTransport records the failed responses in the collector of the context of the request
+type FailedResponsesTransport struct {
+	next http.RoundTripper
+}
+
+func NewFailedResponsesTransport(next http.RoundTripper) *FailedResponsesTransport {
+	return &FailedResponsesTransport{next: next}
+}
+
+func (t *FailedResponsesTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(r)
+	if err != nil || resp.StatusCode < http.StatusBadRequest {
+		return resp, err
+	}
+
+	failedResponses, ok := r.Context().Value(failedResponsesKey{}).(*FailedResponses)
+	if !ok {
+		return resp, err
+	}
+
+	failedResponse := &FailedResponse{
+		RequestID:  resp.Header.Get(requestIDHeader),
+		Method:     r.Method,
+		URL:        r.URL.Redacted(),
+		Status:     resp.Status,
+		StatusCode: resp.StatusCode,
+	}
+
+	if resp.Body != nil {
+		body, readErr := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		if readErr == nil {
+			failedResponse.Body = body[:min(len(body), maxFailedResponseBodySize)]
+		}
+	}
+
+	failedResponses.add(failedResponse)
+
+	return resp, nil
+}
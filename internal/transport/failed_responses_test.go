@@ -0,0 +1,58 @@
+package transport_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFailedResponsesTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "00000000-0000-0000-0000-000000000001")
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"type":"not_found","message":"resource is not found"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: transport.NewFailedResponsesTransport(http.DefaultTransport)}
+	ctx, failedResponses := transport.ContextWithFailedResponses(context.Background())
+
+	for _, path := range []string{"/found", "/missing"} {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+path, nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		if resp.StatusCode == http.StatusNotFound {
+			// The body is still readable by the SDK after being recorded
+			assert.JSONEq(t, `{"type":"not_found","message":"resource is not found"}`, string(body))
+		}
+	}
+
+	responses := failedResponses.All()
+	require.Len(t, responses, 1)
+	assert.Equal(t, "00000000-0000-0000-0000-000000000001", responses[0].RequestID)
+	assert.Equal(t, http.MethodGet, responses[0].Method)
+	assert.Equal(t, server.URL+"/missing", responses[0].URL)
+	assert.Equal(t, http.StatusNotFound, responses[0].StatusCode)
+	assert.Equal(t, "404 Not Found", responses[0].Status)
+
+	// Requests without collector are not recorded
+	resp, err := client.Get(server.URL + "/missing")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Len(t, failedResponses.All(), 1)
+}
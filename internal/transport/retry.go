@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"time"
@@ -35,6 +36,9 @@ func NewRetryableTransportWithOptions(defaultTransport http.RoundTripper, option
 		if resp == nil || resp.StatusCode == http.StatusTooManyRequests {
 			return true, err
 		}
+		if isTransientStateResponse(resp) {
+			return ctx.Err() == nil, ctx.Err()
+		}
 		return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
 	}
 
@@ -83,7 +87,8 @@ func (c *RetryableTransport) RoundTrip(r *http.Request) (*http.Response, error)
 		}
 		body = bytes.NewReader(bs)
 	}
-	req, err := retryablehttp.NewRequest(r.Method, r.URL.String(), body)
+	// Keep the request context so that retries stop with the resource timeout
+	req, err := retryablehttp.NewRequestWithContext(r.Context(), r.Method, r.URL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -112,3 +117,26 @@ func RetryOnTransientStateError[T any, U any](action func() (T, error), waiter f
 	}
 	return t, err
 }
+
+// RetryWhenErrorMatches retries a function while the error it returns matches.
+// When the timeout is reached, the returned error wraps both ErrRetryWhenTimeout and the last error of the function.
+func RetryWhenErrorMatches[T any](ctx context.Context, matches func(error) bool, config *RetryWhenConfig[T]) (T, error) { //nolint: ireturn
+	var lastErr error
+	result, err := retryWhen(ctx, &RetryWhenConfig[T]{
+		Timeout:  config.Timeout,
+		Interval: config.Interval,
+		Function: func() (T, error) {
+			result, err := config.Function()
+			lastErr = err
+
+			return result, err
+		},
+	}, func(err error) bool {
+		return err != nil && matches(err)
+	})
+	if errors.Is(err, ErrRetryWhenTimeout) && lastErr != nil {
+		return result, fmt.Errorf("%w: %w", ErrRetryWhenTimeout, lastErr)
+	}
+
+	return result, err
+}
@@ -0,0 +1,178 @@
+package transport_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryableTransport_TooManyRequests(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	retryMax := 5
+	retryWait := time.Millisecond
+	client := &http.Client{Transport: transport.NewRetryableTransportWithOptions(http.DefaultTransport, transport.RetryableTransportOptions{
+		RetryMax:     &retryMax,
+		RetryWaitMin: &retryWait,
+		RetryWaitMax: &retryWait,
+	})}
+
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestRetryableTransport_TransientState(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"type":"transient_state","resource":"gateway","current_state":"configuring"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	retryMax := 5
+	retryWait := time.Millisecond
+	client := &http.Client{Transport: transport.NewRetryableTransportWithOptions(http.DefaultTransport, transport.RetryableTransportOptions{
+		RetryMax:     &retryMax,
+		RetryWaitMin: &retryWait,
+		RetryWaitMax: &retryWait,
+	})}
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestRetryableTransport_Conflict(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"type":"conflict","message":"resource already exists"}`))
+	}))
+	defer server.Close()
+
+	retryMax := 5
+	retryWait := time.Millisecond
+	client := &http.Client{Transport: transport.NewRetryableTransportWithOptions(http.DefaultTransport, transport.RetryableTransportOptions{
+		RetryMax:     &retryMax,
+		RetryWaitMin: &retryWait,
+		RetryWaitMax: &retryWait,
+	})}
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	assert.Contains(t, string(body), "already exists")
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestIsTransientError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{"transient state", &scw.TransientStateError{Resource: "instance_server"}, true},
+		{"locked", &scw.ResourceLockedError{Resource: "instance_server"}, true},
+		{"wrapped", fmt.Errorf("failed to update: %w", &scw.TransientStateError{}), true},
+		{"resource still in use", &scw.PreconditionFailedError{Precondition: "resource_still_in_use"}, true},
+		{"other precondition", &scw.PreconditionFailedError{Precondition: "attribute_must_be_set"}, false},
+		{"conflict", &scw.ResponseError{StatusCode: http.StatusConflict, Type: "conflict"}, false},
+		{"rate limited", &scw.ResponseError{StatusCode: http.StatusTooManyRequests}, true},
+		{"unavailable", &scw.ResponseError{StatusCode: http.StatusServiceUnavailable}, true},
+		{"not found", &scw.ResourceNotFoundError{Resource: "instance_server"}, false},
+		{"bad request", &scw.ResponseError{StatusCode: http.StatusBadRequest}, false},
+		{"other error", errors.New("transient"), false},
+		{"nil", nil, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, transport.IsTransientError(test.err))
+		})
+	}
+}
+
+func TestRetryableTransport_ContextDeadline(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	retryMax := 100
+	retryWait := 50 * time.Millisecond
+	client := &http.Client{Transport: transport.NewRetryableTransportWithOptions(http.DefaultTransport, transport.RetryableTransportOptions{
+		RetryMax:     &retryMax,
+		RetryWaitMin: &retryWait,
+		RetryWaitMax: &retryWait,
+	})}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(req)
+	if resp != nil {
+		resp.Body.Close()
+	}
+	require.Error(t, err)
+	assert.Less(t, calls.Load(), int32(retryMax))
+}
+
+func TestRetryWhenErrorMatches_TimeoutWrapsLastError(t *testing.T) {
+	notFound := &scw.ResourceNotFoundError{Resource: "private_network", ResourceID: "11111111-1111-1111-1111-111111111111"}
+
+	_, err := transport.RetryWhenErrorMatches(context.Background(), func(error) bool { return true }, &transport.RetryWhenConfig[any]{
+		Timeout:  10 * time.Millisecond,
+		Interval: time.Millisecond,
+		Function: func() (any, error) {
+			return nil, notFound
+		},
+	})
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, transport.ErrRetryWhenTimeout)
+	assert.ErrorIs(t, err, notFound)
+	assert.Contains(t, err.Error(), "private_network")
+}
@@ -0,0 +1,75 @@
+package transport
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"sync"
+)
+
+type rootCAsKey struct{}
+
+// ContextWithRootCAs returns a context in which the requests sent through a RootCAsTransport trust the given certificate authorities in PEM format,
+// e.g. the certificate authority of the Kubernetes API of a cluster.
+func ContextWithRootCAs(ctx context.Context, rootCAs []byte) context.Context {
+	return context.WithValue(ctx, rootCAsKey{}, rootCAs)
+}
+
+// RootCAsTransport sends the requests with the certificate authorities of their context,
+// the other requests are sent with http.DefaultTransport.
+type RootCAsTransport struct{}
+
+func NewRootCAsTransport() *RootCAsTransport {
+	return &RootCAsTransport{}
+}
+
+func (t *RootCAsTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	rootCAs, ok := r.Context().Value(rootCAsKey{}).([]byte)
+	if !ok {
+		return http.DefaultTransport.RoundTrip(r)
+	}
+
+	transport, err := RootCAsHTTPTransport(rootCAs)
+	if err != nil {
+		return nil, err
+	}
+
+	return transport.RoundTrip(r)
+}
+
+// rootCAsTransports are the transports returned by RootCAsHTTPTransport, by certificate authorities
+var rootCAsTransports = struct {
+	mu         sync.Mutex
+	transports map[string]*http.Transport
+}{
+	transports: map[string]*http.Transport{},
+}
+
+// RootCAsHTTPTransport returns a transport trusting the given certificate authorities in PEM format,
+// with the proxy and timeouts of http.DefaultTransport.
+// The requests trusting the same certificate authorities share a transport so their connections are reused.
+func RootCAsHTTPTransport(rootCAs []byte) (*http.Transport, error) {
+	rootCAsTransports.mu.Lock()
+	defer rootCAsTransports.mu.Unlock()
+
+	if transport, ok := rootCAsTransports.transports[string(rootCAs)]; ok {
+		return transport, nil
+	}
+
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(rootCAs) {
+		return nil, errors.New("failed to parse certificate authority")
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyFromEnvironment
+	transport.TLSClientConfig = &tls.Config{
+		RootCAs:    certPool,
+		MinVersion: tls.VersionTLS12,
+	}
+	rootCAsTransports.transports[string(rootCAs)] = transport
+
+	return transport, nil
+}
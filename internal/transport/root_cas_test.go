@@ -0,0 +1,51 @@
+package transport_test
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRootCAsTransport_TrustsContextRootCAs(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: transport.NewRootCAsTransport()}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	_, err = client.Do(req)
+	require.Error(t, err, "the certificate of the server is not trusted without its certificate authority")
+
+	rootCAs := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	req, err = http.NewRequestWithContext(transport.ContextWithRootCAs(context.Background(), rootCAs), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestRootCAsHTTPTransport_SharedByCertificateAuthority(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+	defer server.Close()
+
+	rootCAs := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	first, err := transport.RootCAsHTTPTransport(rootCAs)
+	require.NoError(t, err)
+	second, err := transport.RootCAsHTTPTransport(append([]byte(nil), rootCAs...))
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+
+	_, err = transport.RootCAsHTTPTransport([]byte("invalid"))
+	require.Error(t, err)
+}
@@ -0,0 +1,82 @@
+package transport
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SessionTokenHeader is the header used by the Scaleway API to authenticate requests with a session token
+const SessionTokenHeader = "X-Session-Token" // #nosec G101
+
+// SessionTokenFileTransport authenticates requests with a session token read from a file.
+// The file is read again each time it is modified, which allows an external process
+// (e.g. a workload identity agent) to refresh short-lived tokens during long applies.
+type SessionTokenFileTransport struct {
+	next http.RoundTripper
+	path string
+
+	mu      sync.Mutex
+	token   string
+	modTime time.Time
+}
+
+// NewSessionTokenFileTransport creates a transport authenticating requests with the session token stored at path
+func NewSessionTokenFileTransport(next http.RoundTripper, path string) (*SessionTokenFileTransport, error) {
+	t := &SessionTokenFileTransport{
+		next: next,
+		path: path,
+	}
+
+	if _, err := t.Token(); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// Token returns the current session token, reading the file again if it changed since the last read
+func (t *SessionTokenFileTransport) Token() (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	info, err := os.Stat(t.path)
+	if err != nil {
+		return "", fmt.Errorf("cannot read session token file: %w", err)
+	}
+
+	if t.token != "" && info.ModTime().Equal(t.modTime) {
+		return t.token, nil
+	}
+
+	content, err := os.ReadFile(t.path)
+	if err != nil {
+		return "", fmt.Errorf("cannot read session token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", fmt.Errorf("session token file %s is empty", t.path)
+	}
+
+	t.token = token
+	t.modTime = info.ModTime()
+
+	return t.token, nil
+}
+
+// RoundTrip sets the session token header of the request with the current token
+func (t *SessionTokenFileTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	token, err := t.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	r = r.Clone(r.Context())
+	r.Header.Set(SessionTokenHeader, token)
+
+	return t.next.RoundTrip(r)
+}
@@ -0,0 +1,54 @@
+package transport_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionTokenFileTransport(t *testing.T) {
+	var tokens []string
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		tokens = append(tokens, r.Header.Get(transport.SessionTokenHeader))
+	}))
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("first-token\n"), 0o600))
+
+	sessionTokenTransport, err := transport.NewSessionTokenFileTransport(http.DefaultTransport, tokenFile)
+	require.NoError(t, err)
+	client := &http.Client{Transport: sessionTokenTransport}
+
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	// Simulate a refresh of the token by an external process
+	require.NoError(t, os.WriteFile(tokenFile, []byte("second-token\n"), 0o600))
+	refreshTime := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(tokenFile, refreshTime, refreshTime))
+
+	resp, err = client.Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, []string{"first-token", "second-token"}, tokens)
+}
+
+func TestSessionTokenFileTransport_InvalidFile(t *testing.T) {
+	_, err := transport.NewSessionTokenFileTransport(http.DefaultTransport, filepath.Join(t.TempDir(), "missing"))
+	assert.Error(t, err)
+
+	emptyFile := filepath.Join(t.TempDir(), "empty")
+	require.NoError(t, os.WriteFile(emptyFile, nil, 0o600))
+	_, err = transport.NewSessionTokenFileTransport(http.DefaultTransport, emptyFile)
+	assert.Error(t, err)
+}
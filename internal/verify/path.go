@@ -0,0 +1,37 @@
+package verify
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var pathRegex = regexp.MustCompile(`^/([a-zA-Z0-9_.-]+/?)*$`)
+
+// IsPath will validate that field is an absolute slash separated path like /env/prod
+// Each element of the path may only contain alphanumeric characters, dashes, underscores and dots
+func IsPath() schema.SchemaValidateDiagFunc {
+	return func(value interface{}, path cty.Path) diag.Diagnostics {
+		p, isStr := value.(string)
+		if !isStr {
+			return diag.Diagnostics{diag.Diagnostic{
+				Severity:      diag.Error,
+				AttributePath: path,
+				Summary:       "invalid input, expected a string",
+			}}
+		}
+
+		if !pathRegex.MatchString(p) {
+			return diag.Diagnostics{diag.Diagnostic{
+				Severity:      diag.Error,
+				AttributePath: path,
+				Summary:       "invalid input, expected an absolute path like /env/prod",
+				Detail:        "got " + p,
+			}}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,23 @@
+package verify_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsPathWithValidPathReturnNothing(t *testing.T) {
+	for _, path := range []string{"/", "/env", "/env/prod", "/env/prod/", "/my-app/db_1.0"} {
+		diags := verify.IsPath()(path, cty.Path{})
+		assert.Empty(t, diags, path)
+	}
+}
+
+func TestIsPathWithInvalidPathReturnError(t *testing.T) {
+	for _, path := range []string{"", "env/prod", "/env//prod", "/env/prod space", "/env/prod$"} {
+		diags := verify.IsPath()(path, cty.Path{})
+		assert.Len(t, diags, 1, path)
+	}
+}
@@ -0,0 +1,34 @@
+package verify
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// IsVersionConstraint will validate that field is a version constraint like ">= 22.04, < 24"
+func IsVersionConstraint() schema.SchemaValidateDiagFunc {
+	return func(value interface{}, path cty.Path) diag.Diagnostics {
+		str, isStr := value.(string)
+		if !isStr {
+			return diag.Diagnostics{diag.Diagnostic{
+				Severity:      diag.Error,
+				AttributePath: path,
+				Summary:       "invalid input, expected a string",
+			}}
+		}
+
+		_, err := version.NewConstraint(str)
+		if err != nil {
+			return diag.Diagnostics{diag.Diagnostic{
+				Severity:      diag.Error,
+				AttributePath: path,
+				Summary:       "invalid input, expected a valid version constraint",
+				Detail:        err.Error(),
+			}}
+		}
+
+		return nil
+	}
+}
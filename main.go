@@ -5,9 +5,8 @@ import (
 	"flag"
 	"log"
 
-	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
-	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
-	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/provider"
 )
 
@@ -17,24 +16,22 @@ func main() {
 	var debugMode bool
 	flag.BoolVar(&debugMode, "debuggable", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
-	var serveOpts []tf5server.ServeOpt
+	var serveOpts []tf6server.ServeOpt
 	if debugMode {
-		serveOpts = append(serveOpts, tf5server.WithManagedDebug())
+		serveOpts = append(serveOpts, tf6server.WithManagedDebug())
 	}
 
-	providers := []func() tfprotov5.ProviderServer{
-		// Provider using terraform-plugin-sdk
-		provider.Provider(provider.DefaultConfig())().GRPCProvider,
-	}
-
-	muxServer, err := tf5muxserver.NewMuxServer(ctx, providers...)
+	// Provider using terraform-plugin-sdk muxed with the provider using terraform-plugin-framework
+	muxServer, err := provider.NewMuxServer(ctx, provider.DefaultConfig())
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	err = tf5server.Serve(
+	err = tf6server.Serve(
 		"registry.terraform.io/scaleway/scaleway",
-		muxServer.ProviderServer,
+		func() tfprotov6.ProviderServer {
+			return muxServer
+		},
 		serveOpts...,
 	)
 	if err != nil {